@@ -46,3 +46,84 @@ func AppendWithEscape(buf []byte, s string) []byte {
 	}
 	return buf
 }
+
+// CheckDeletionProtection returns error diagnostics if deletion_protection is enabled for the entity.
+func CheckDeletionProtection(d *schema.ResourceData, entity, name string) diag.Diagnostics {
+	if protected, _ := d.Get("deletion_protection").(bool); !protected {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s %q is protected from deletion", entity, name),
+			Detail:   fmt.Sprintf("deletion_protection is enabled, set it to false and apply the change before deleting the %s", entity),
+		},
+	}
+}
+
+// PlanTableReference plans table_path and table_id of the entity attached to a table, e.g. index
+// or changefeed, when the table is moved: the attribute which is not declared follows the declared
+// one. Moving the entity into the table of another database forces replacement.
+func PlanTableReference(d *schema.ResourceDiff) error {
+	if d.Id() == "" {
+		return nil
+	}
+	switch {
+	case d.HasChange("table_id"):
+		if !d.NewValueKnown("table_id") {
+			return d.SetNewComputed("table_path")
+		}
+		o, n := d.GetChange("table_id")
+		newTable, err := ParseYDBEntityID(n.(string))
+		if err != nil {
+			return fmt.Errorf("failed to parse table_id: %w", err)
+		}
+		oldTable, err := ParseYDBEntityID(o.(string))
+		if err == nil && oldTable.PrepareFullYDBEndpoint() != newTable.PrepareFullYDBEndpoint() {
+			return d.ForceNew("table_id")
+		}
+		return d.SetNew("table_path", newTable.GetEntityPath())
+	case d.HasChange("table_path"):
+		if !d.NewValueKnown("table_path") {
+			return d.SetNewComputed("table_id")
+		}
+		return d.SetNew("table_id", d.Get("connection_string").(string)+"?path="+d.Get("table_path").(string))
+	}
+	return nil
+}
+
+// DataSourceConnectionString returns connection_string of the data source, the provider
+// endpoint is used if it is not set.
+func DataSourceConnectionString(d *schema.ResourceData, defaultConnectionString string) (string, error) {
+	connectionString := d.Get("connection_string").(string)
+	if connectionString == "" {
+		connectionString = defaultConnectionString
+	}
+	if connectionString == "" {
+		return "", fmt.Errorf("connection_string is not set and provider endpoint is not configured")
+	}
+	return connectionString, nil
+}
+
+// AppendStringWithEscape appends s escaped to be used inside of double-quoted string literal.
+// Control bytes are escaped too, so the literal does not span several lines of the query.
+func AppendStringWithEscape(buf []byte, s string) []byte {
+	const hexDigits = "0123456789abcdef"
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"' || c == '\\':
+			buf = append(buf, '\\', c)
+		case c == '\n':
+			buf = append(buf, '\\', 'n')
+		case c == '\r':
+			buf = append(buf, '\\', 'r')
+		case c == '\t':
+			buf = append(buf, '\\', 't')
+		case c < 0x20 || c == 0x7f:
+			buf = append(buf, '\\', 'x', hexDigits[c>>4], hexDigits[c&0xf])
+		default:
+			buf = append(buf, c)
+		}
+	}
+	return buf
+}
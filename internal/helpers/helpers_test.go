@@ -1,6 +1,12 @@
 package helpers
 
-import "testing"
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
 
 func TestParseYDBDatabaseEndpoint(t *testing.T) {
 	testData := []struct {
@@ -84,3 +90,178 @@ func TestParseYDBDatabaseEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckDeletionProtection(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"deletion_protection": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+	}
+	testData := []struct {
+		testName    string
+		raw         map[string]interface{}
+		expectedErr bool
+	}{
+		{
+			testName: "protection is not set",
+			raw:      map[string]interface{}{},
+		},
+		{
+			testName: "protection is disabled",
+			raw: map[string]interface{}{
+				"deletion_protection": false,
+			},
+		},
+		{
+			testName: "protection is enabled",
+			raw: map[string]interface{}{
+				"deletion_protection": true,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceSchema, v.raw)
+			got := CheckDeletionProtection(d, "table", "abacaba")
+			if got.HasError() != v.expectedErr {
+				t.Errorf("got diagnostics %v, but expected error: %v", got, v.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPlanTableReference(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"table_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"connection_string": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"table_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return PlanTableReference(d)
+		},
+	}
+	testData := []struct {
+		testName          string
+		config            map[string]interface{}
+		expectedTablePath string
+		expectedTableID   string
+		expectedReplace   bool
+	}{
+		{
+			testName: "table_path is changed",
+			config: map[string]interface{}{
+				"table_path":        "dir/renamed",
+				"connection_string": "grpc://localhost:2136/?database=/local",
+			},
+			expectedTablePath: "dir/renamed",
+			expectedTableID:   "grpc://localhost:2136/?database=/local?path=dir/renamed",
+		},
+		{
+			testName: "table_id is changed",
+			config: map[string]interface{}{
+				"table_id": "grpc://localhost:2136/?database=/local?path=dir/renamed",
+			},
+			expectedTablePath: "dir/renamed",
+			expectedTableID:   "grpc://localhost:2136/?database=/local?path=dir/renamed",
+		},
+		{
+			testName: "table_id is changed to another database",
+			config: map[string]interface{}{
+				"table_id": "grpc://localhost:2136/?database=/other?path=table",
+			},
+			expectedReplace: true,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "grpc://localhost:2136/?database=/local?path=table/index",
+				Attributes: map[string]string{
+					"table_path":        "table",
+					"connection_string": "grpc://localhost:2136/?database=/local",
+					"table_id":          "grpc://localhost:2136/?database=/local?path=table",
+				},
+			}
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(v.config), nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff.RequiresNew() != v.expectedReplace {
+				t.Fatalf("got replacement %v, but expected %v", diff.RequiresNew(), v.expectedReplace)
+			}
+			if v.expectedReplace {
+				return
+			}
+			if got := diff.Attributes["table_path"].New; got != v.expectedTablePath {
+				t.Errorf("got table_path %q, but expected %q", got, v.expectedTablePath)
+			}
+			if got := diff.Attributes["table_id"].New; got != v.expectedTableID {
+				t.Errorf("got table_id %q, but expected %q", got, v.expectedTableID)
+			}
+		})
+	}
+}
+
+func TestAppendStringWithEscape(t *testing.T) {
+	testData := []struct {
+		testName string
+		s        string
+		expected string
+	}{
+		{
+			testName: "plain string",
+			s:        "abacaba",
+			expected: "abacaba",
+		},
+		{
+			testName: "quotes and backslashes",
+			s:        `a"b\c/d`,
+			expected: `a\"b\\c/d`,
+		},
+		{
+			testName: "line breaks and tabs",
+			s:        "a\nb\r\nc\td",
+			expected: `a\nb\r\nc\td`,
+		},
+		{
+			testName: "control bytes",
+			s:        "a\x00b\x1bc\x7f",
+			expected: `a\x00b\x1bc\x7f`,
+		},
+		{
+			testName: "non-ascii string",
+			s:        "пароль",
+			expected: "пароль",
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			got := string(AppendStringWithEscape(nil, v.s))
+			if got != v.expected {
+				t.Errorf("got %q, expected %q", got, v.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package yqltypes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	maxDecimalPrecision = 35
+)
+
+var (
+	// primitiveTypes maps lowercase type names and aliases to canonical YQL type names,
+	// other type names are kept as they are written.
+	primitiveTypes = map[string]string{
+		"bool":         "Bool",
+		"int8":         "Int8",
+		"int16":        "Int16",
+		"int32":        "Int32",
+		"int64":        "Int64",
+		"uint8":        "Uint8",
+		"uint16":       "Uint16",
+		"uint32":       "Uint32",
+		"uint64":       "Uint64",
+		"float":        "Float",
+		"double":       "Double",
+		"date":         "Date",
+		"datetime":     "Datetime",
+		"timestamp":    "Timestamp",
+		"interval":     "Interval",
+		"tzdate":       "TzDate",
+		"tzdatetime":   "TzDatetime",
+		"tztimestamp":  "TzTimestamp",
+		"string":       "String",
+		"bytes":        "String",
+		"utf8":         "Utf8",
+		"text":         "Utf8",
+		"yson":         "Yson",
+		"json":         "Json",
+		"jsondocument": "JsonDocument",
+		"uuid":         "Uuid",
+		"dynumber":     "DyNumber",
+	}
+)
+
+// Type is a parsed YQL data type, e.g. `Optional<Decimal(22,9)>`.
+type Type struct {
+	// Name is a canonical type name, e.g. "Uint64", "Utf8" or "Decimal".
+	Name      string
+	Precision uint32
+	Scale     uint32
+	Optional  bool
+}
+
+func (t Type) String() string {
+	inner := t.Name
+	if t.Name == "Decimal" {
+		inner = "Decimal(" + strconv.FormatUint(uint64(t.Precision), 10) + "," + strconv.FormatUint(uint64(t.Scale), 10) + ")"
+	}
+	if t.Optional {
+		return "Optional<" + inner + ">"
+	}
+	return inner
+}
+
+// Inner returns the type without Optional wrapper.
+func (t Type) Inner() Type {
+	t.Optional = false
+	return t
+}
+
+type parser struct {
+	s   string
+	pos int
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.s) && unicode.IsSpace(rune(p.s[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *parser) ident() string {
+	p.skipSpaces()
+	begin := p.pos
+	for p.pos < len(p.s) && (unicode.IsLetter(rune(p.s[p.pos])) || unicode.IsDigit(rune(p.s[p.pos])) || p.s[p.pos] == '_') {
+		p.pos++
+	}
+	return p.s[begin:p.pos]
+}
+
+func (p *parser) number() (uint32, error) {
+	p.skipSpaces()
+	begin := p.pos
+	for p.pos < len(p.s) && unicode.IsDigit(rune(p.s[p.pos])) {
+		p.pos++
+	}
+	v, err := strconv.ParseUint(p.s[begin:p.pos], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("expected number at position %d", begin)
+	}
+	return uint32(v), nil
+}
+
+func (p *parser) expect(c byte) error {
+	p.skipSpaces()
+	if p.pos >= len(p.s) || p.s[p.pos] != c {
+		return fmt.Errorf("expected %q at position %d", c, p.pos)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) peek(c byte) bool {
+	p.skipSpaces()
+	return p.pos < len(p.s) && p.s[p.pos] == c
+}
+
+func (p *parser) parseType() (t Type, err error) {
+	name := p.ident()
+	if name == "" {
+		return t, fmt.Errorf("expected type name at position %d", p.pos)
+	}
+	switch strings.ToLower(name) {
+	case "optional":
+		if err = p.expect('<'); err != nil {
+			return t, err
+		}
+		t, err = p.parseType()
+		if err != nil {
+			return t, err
+		}
+		if t.Optional {
+			return t, fmt.Errorf("nested optional types are not supported")
+		}
+		if err = p.expect('>'); err != nil {
+			return t, err
+		}
+		t.Optional = true
+		return t, nil
+	case "decimal":
+		t.Name = "Decimal"
+		if err = p.expect('('); err != nil {
+			return t, err
+		}
+		if t.Precision, err = p.number(); err != nil {
+			return t, err
+		}
+		if err = p.expect(','); err != nil {
+			return t, err
+		}
+		if t.Scale, err = p.number(); err != nil {
+			return t, err
+		}
+		if err = p.expect(')'); err != nil {
+			return t, err
+		}
+		if t.Precision == 0 || t.Precision > maxDecimalPrecision || t.Scale > t.Precision {
+			return t, fmt.Errorf("invalid decimal precision %d and scale %d", t.Precision, t.Scale)
+		}
+	default:
+		// NOTE: types unknown to the provider, e.g. Date32 or Pg types, are passed to the server as is.
+		t.Name = name
+		if canonical, ok := primitiveTypes[strings.ToLower(name)]; ok {
+			t.Name = canonical
+		}
+	}
+	if p.peek('?') {
+		p.pos++
+		t.Optional = true
+	}
+	return t, nil
+}
+
+// Parse parses YQL data type. Type names are case-insensitive, `Text` and `Bytes`
+// are accepted as aliases of `Utf8` and `String`, `T?` is the same as `Optional<T>`.
+// Names of types unknown to the provider are kept as they are written.
+func Parse(s string) (Type, error) {
+	p := &parser{s: s}
+	t, err := p.parseType()
+	if err != nil {
+		return Type{}, fmt.Errorf("failed to parse type %q: %w", s, err)
+	}
+	p.skipSpaces()
+	if p.pos != len(p.s) {
+		return Type{}, fmt.Errorf("failed to parse type %q: unexpected %q at position %d", s, p.s[p.pos:], p.pos)
+	}
+	return t, nil
+}
+
+// Normalize returns canonical form of the type.
+func Normalize(s string) (string, error) {
+	t, err := Parse(s)
+	if err != nil {
+		return "", err
+	}
+	return t.String(), nil
+}
+
+// NormalizeColumnType returns canonical form of the column type without Optional wrapper,
+// nullability of columns is set separately.
+func NormalizeColumnType(s string) (string, error) {
+	t, err := Parse(s)
+	if err != nil {
+		return "", err
+	}
+	return t.Inner().String(), nil
+}
+
+func ValidateColumnType(v interface{}, k string) (ws []string, es []error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if _, err := Parse(s); err != nil {
+		return nil, []error{fmt.Errorf("%q: %w", k, err)}
+	}
+	return nil, nil
+}
+
+func SuppressEquivalentColumnTypes(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+	o, err := NormalizeColumnType(old)
+	if err != nil {
+		return false
+	}
+	n, err := NormalizeColumnType(new)
+	if err != nil {
+		return false
+	}
+	return o == n
+}
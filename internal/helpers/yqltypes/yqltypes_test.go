@@ -0,0 +1,211 @@
+package yqltypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestNormalize(t *testing.T) {
+	testData := []struct {
+		testName    string
+		typ         string
+		expected    string
+		expectedErr bool
+	}{
+		{
+			testName: "primitive type",
+			typ:      "Uint64",
+			expected: "Uint64",
+		},
+		{
+			testName: "case insensitive",
+			typ:      "uint64",
+			expected: "Uint64",
+		},
+		{
+			testName: "text alias",
+			typ:      "Text",
+			expected: "Utf8",
+		},
+		{
+			testName: "bytes alias",
+			typ:      "bytes",
+			expected: "String",
+		},
+		{
+			testName: "decimal with spaces",
+			typ:      " Decimal( 22 , 9 ) ",
+			expected: "Decimal(22,9)",
+		},
+		{
+			testName: "optional",
+			typ:      "optional<Json>",
+			expected: "Optional<Json>",
+		},
+		{
+			testName: "optional shorthand",
+			typ:      "Utf8?",
+			expected: "Optional<Utf8>",
+		},
+		{
+			testName: "optional decimal",
+			typ:      "Optional<Decimal(35,10)>",
+			expected: "Optional<Decimal(35,10)>",
+		},
+		{
+			testName: "type unknown to the provider",
+			typ:      "Date32",
+			expected: "Date32",
+		},
+		{
+			testName: "optional type unknown to the provider",
+			typ:      "Optional<Timestamp64>",
+			expected: "Optional<Timestamp64>",
+		},
+		{
+			testName: "pg type",
+			typ:      "pgint4?",
+			expected: "Optional<pgint4>",
+		},
+		{
+			testName:    "empty type",
+			typ:         "",
+			expectedErr: true,
+		},
+		{
+			testName:    "decimal without params",
+			typ:         "Decimal",
+			expectedErr: true,
+		},
+		{
+			testName:    "decimal with too big precision",
+			typ:         "Decimal(36,9)",
+			expectedErr: true,
+		},
+		{
+			testName:    "decimal with scale greater than precision",
+			typ:         "Decimal(5,9)",
+			expectedErr: true,
+		},
+		{
+			testName:    "nested optional",
+			typ:         "Optional<Optional<Utf8>>",
+			expectedErr: true,
+		},
+		{
+			testName:    "unclosed optional",
+			typ:         "Optional<Utf8",
+			expectedErr: true,
+		},
+		{
+			testName:    "trailing garbage",
+			typ:         "Utf8 Utf8",
+			expectedErr: true,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			got, err := Normalize(v.typ)
+			if v.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, v.expected, got)
+		})
+	}
+}
+
+func TestNormalizeServerTypes(t *testing.T) {
+	testData := []struct {
+		testName string
+		typ      types.Type
+	}{
+		{
+			testName: "primitive type",
+			typ:      types.TypeString,
+		},
+		{
+			testName: "optional type",
+			typ:      types.Optional(types.TypeUTF8),
+		},
+		{
+			testName: "decimal type",
+			typ:      types.DecimalType(22, 9),
+		},
+		{
+			testName: "optional decimal type",
+			typ:      types.Optional(types.DecimalType(35, 10)),
+		},
+		{
+			testName: "timezone type",
+			typ:      types.TypeTzTimestamp,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			got, err := Normalize(v.typ.Yql())
+			assert.NoError(t, err)
+			assert.Equal(t, v.typ.Yql(), got)
+		})
+	}
+}
+
+func TestSuppressEquivalentColumnTypes(t *testing.T) {
+	testData := []struct {
+		testName string
+		old      string
+		new      string
+		expected bool
+	}{
+		{
+			testName: "same types",
+			old:      "Utf8",
+			new:      "Utf8",
+			expected: true,
+		},
+		{
+			testName: "alias",
+			old:      "String",
+			new:      "Bytes",
+			expected: true,
+		},
+		{
+			testName: "optional wrapper",
+			old:      "Utf8",
+			new:      "Optional<Text>",
+			expected: true,
+		},
+		{
+			testName: "decimal formatting",
+			old:      "Decimal(22,9)",
+			new:      "decimal(22, 9)",
+			expected: true,
+		},
+		{
+			testName: "different types",
+			old:      "Uint32",
+			new:      "Uint64",
+			expected: false,
+		},
+		{
+			testName: "invalid type",
+			old:      "Utf8",
+			new:      "Utf",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			assert.Equal(t, v.expected, SuppressEquivalentColumnTypes("column.0.type", v.old, v.new, nil))
+		})
+	}
+}
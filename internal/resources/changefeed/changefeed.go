@@ -29,7 +29,7 @@ var (
 	}
 )
 
-type changeDataCaptureSettings struct {
+type ChangeDataCaptureSettings struct {
 	ConnectionString  string
 	TablePath         string
 	Name              string
@@ -42,14 +42,14 @@ type changeDataCaptureSettings struct {
 	Consumers         []topictypes.Consumer
 }
 
-func (c *changeDataCaptureSettings) getTablePath() string {
+func (c *ChangeDataCaptureSettings) getTablePath() string {
 	if c.TablePath != "" {
 		return c.TablePath
 	}
 	return c.TableEntity.GetEntityPath()
 }
 
-func (c *changeDataCaptureSettings) getConnectionString() string {
+func (c *ChangeDataCaptureSettings) getConnectionString() string {
 	if c.ConnectionString != "" {
 		return c.ConnectionString
 	}
@@ -62,9 +62,12 @@ func expandConsumers(d *schema.ResourceData) []topictypes.Consumer {
 		return nil
 	}
 
-	pSet := v.(*schema.Set)
-	result := make([]topictypes.Consumer, 0, len(pSet.List()))
-	for _, l := range pSet.List() {
+	return expandConsumerList(v.(*schema.Set).List())
+}
+
+func expandConsumerList(consumers []interface{}) []topictypes.Consumer {
+	result := make([]topictypes.Consumer, 0, len(consumers))
+	for _, l := range consumers {
 		consumer := l.(map[string]interface{})
 		supportedCodecs, ok := consumer["supported_codecs"].([]interface{})
 		if !ok {
@@ -92,7 +95,70 @@ func expandConsumers(d *schema.ResourceData) []topictypes.Consumer {
 	return result
 }
 
-func changefeedResourceSchemaToChangefeedResource(d *schema.ResourceData) (*changeDataCaptureSettings, error) {
+// ExpandChangefeed expands changefeed declared inside of ydb_table resource.
+func ExpandChangefeed(tablePath string, mp map[string]interface{}) *ChangeDataCaptureSettings {
+	settings := &ChangeDataCaptureSettings{
+		TablePath: tablePath,
+		Name:      mp["name"].(string),
+		Mode:      mp["mode"].(string),
+	}
+	if format, ok := mp["format"].(string); ok && format != "" {
+		settings.Format = &format
+	}
+	if virtualTimestamps, ok := mp["virtual_timestamps"].(bool); ok {
+		settings.VirtualTimestamps = &virtualTimestamps
+	}
+	if retentionPeriod, ok := mp["retention_period"].(string); ok && retentionPeriod != "" {
+		settings.RetentionPeriod = &retentionPeriod
+	}
+	if consumers, ok := mp["consumer"].(*schema.Set); ok {
+		settings.Consumers = expandConsumerList(consumers.List())
+	}
+	return settings
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalBoolPtr(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// SameSettings reports whether changefeeds differ only in consumers,
+// other settings can not be changed without recreation of changefeed.
+func (c *ChangeDataCaptureSettings) SameSettings(other *ChangeDataCaptureSettings) bool {
+	return c.Name == other.Name &&
+		c.Mode == other.Mode &&
+		equalStringPtr(c.Format, other.Format) &&
+		equalStringPtr(c.RetentionPeriod, other.RetentionPeriod) &&
+		equalBoolPtr(c.VirtualTimestamps, other.VirtualTimestamps)
+}
+
+// FlattenChangefeed flattens changefeed declared inside of ydb_table resource.
+// virtual_timestamps and retention_period are not returned by server and are kept from declared.
+func FlattenChangefeed(
+	declared map[string]interface{},
+	cdcDescription options.ChangefeedDescription,
+	consumers []topictypes.Consumer,
+) map[string]interface{} {
+	return map[string]interface{}{
+		"name":               cdcDescription.Name,
+		"mode":               changefeedModeToStringMap[cdcDescription.Mode],
+		"format":             changefeedFormatToStringMap[cdcDescription.Format],
+		"virtual_timestamps": declared["virtual_timestamps"],
+		"retention_period":   declared["retention_period"],
+		"consumer":           topic.FlattenConsumersDescription(consumers),
+	}
+}
+
+func changefeedResourceSchemaToChangefeedResource(d *schema.ResourceData) (*ChangeDataCaptureSettings, error) {
 	var entity *helpers.YDBEntity
 	var err error
 	if d.Id() != "" {
@@ -111,7 +177,7 @@ func changefeedResourceSchemaToChangefeedResource(d *schema.ResourceData) (*chan
 		tableEntity = en
 	}
 
-	settings := &changeDataCaptureSettings{
+	settings := &ChangeDataCaptureSettings{
 		Entity:           entity,
 		ConnectionString: d.Get("connection_string").(string),
 		Name:             d.Get("name").(string),
@@ -135,7 +201,7 @@ func changefeedResourceSchemaToChangefeedResource(d *schema.ResourceData) (*chan
 
 func flattenCDCDescription(
 	d *schema.ResourceData,
-	changefeedResource *changeDataCaptureSettings,
+	changefeedResource *ChangeDataCaptureSettings,
 	cdcDescription options.ChangefeedDescription,
 	consumers []topictypes.Consumer,
 ) (err error) {
@@ -2,11 +2,15 @@ package changefeed
 
 import (
 	"context"
+	"fmt"
+	"path"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
 
@@ -15,6 +19,10 @@ import (
 )
 
 func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChangeExcept("deletion_protection") {
+		return h.Read(ctx, d, meta)
+	}
+
 	cdcResource, err := changefeedResourceSchemaToChangefeedResource(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -37,6 +45,19 @@ func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta inter
 		_ = db.Close(ctx)
 	}()
 
+	if d.HasChanges("table_path", "table_id") {
+		// NOTE: table_path differs from the table in ID when the table was renamed.
+		oldTablePath := parseTablePathFromCDCEntity(cdcResource.Entity.GetEntityPath())
+		protected := d.Get("deletion_protection").(bool)
+		err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			return moveChangefeed(ctx, s, db.Name(), oldTablePath, cdcResource, protected)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(cdcResource.getConnectionString() + "?path=" + cdcResource.getTablePath() + "/" + cdcResource.Name)
+	}
+
 	topicPath := cdcResource.getTablePath() + "/" + cdcResource.Name
 	desc, err := db.Topic().Describe(ctx, topicPath)
 	if err != nil {
@@ -52,7 +73,78 @@ func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta inter
 	return h.Read(ctx, d, meta)
 }
 
+// tableSession is a part of table.Session used to move changefeed.
+type tableSession interface {
+	DescribeTable(ctx context.Context, path string, opts ...options.DescribeTableOption) (options.Description, error)
+	ExecuteSchemeQuery(ctx context.Context, query string, opts ...options.ExecuteSchemeQueryOption) error
+}
+
+// moveChangefeed moves the changefeed to the table at cdc's path. The changefeed found there is
+// kept: it was moved together with the renamed table. Otherwise it is dropped from the table at
+// oldTablePath, if it is left there, and created on the table. Consumers are added by the caller.
+func moveChangefeed(
+	ctx context.Context,
+	s tableSession,
+	database string,
+	oldTablePath string,
+	cdc *ChangeDataCaptureSettings,
+	protected bool,
+) error {
+	tablePath := cdc.getTablePath()
+	found, err := hasChangefeed(ctx, s, path.Join(database, tablePath), cdc.Name)
+	if err != nil {
+		return fmt.Errorf("failed to describe table %q: %w", tablePath, err)
+	}
+	if found {
+		return nil
+	}
+
+	if oldTablePath != tablePath {
+		found, err = hasChangefeed(ctx, s, path.Join(database, oldTablePath), cdc.Name)
+		if err != nil && !strings.Contains(err.Error(), "SCHEME_ERROR") {
+			return fmt.Errorf("failed to describe table %q: %w", oldTablePath, err)
+		}
+	}
+	if found {
+		if protected {
+			return fmt.Errorf(
+				"changefeed %q is protected from deletion, set deletion_protection to false and apply the change before moving the changefeed",
+				cdc.Name,
+			)
+		}
+		err = s.ExecuteSchemeQuery(ctx, PrepareDropRequest(oldTablePath, cdc.Name))
+		if err != nil {
+			return fmt.Errorf("failed to drop changefeed %q from table %q: %w", cdc.Name, oldTablePath, err)
+		}
+	}
+
+	err = s.ExecuteSchemeQuery(ctx, PrepareCreateRequest(cdc))
+	if err != nil {
+		return fmt.Errorf("failed to create changefeed %q on table %q: %w", cdc.Name, tablePath, err)
+	}
+	return nil
+}
+
+func hasChangefeed(ctx context.Context, s tableSession, tablePath, name string) (bool, error) {
+	description, err := s.DescribeTable(ctx, tablePath)
+	if err != nil {
+		return false, err
+	}
+	for _, v := range description.Changefeeds {
+		if v.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func mergeConsumerSettings(d *schema.ResourceData, readRules []topictypes.Consumer) (opts []topicoptions.AlterOption) {
+	return MergeConsumerSettings(d.Get("consumer").(*schema.Set).List(), readRules)
+}
+
+// MergeConsumerSettings returns options to alter consumers of changefeed topic from
+// readRules to declared consumers. Consumers which are not declared are dropped.
+func MergeConsumerSettings(consumers []interface{}, readRules []topictypes.Consumer) (opts []topicoptions.AlterOption) {
 	rules := make(map[string]topictypes.Consumer, len(readRules))
 	for i := 0; i < len(readRules); i++ {
 		rules[readRules[i].Name] = readRules[i]
@@ -61,8 +153,7 @@ func mergeConsumerSettings(d *schema.ResourceData, readRules []topictypes.Consum
 	// TODO(shmel1k@): remove copypaste
 	consumersMap := make(map[string]struct{})
 
-	pSet := d.Get("consumer").(*schema.Set)
-	for _, v := range pSet.List() {
+	for _, v := range consumers {
 		consumer := v.(map[string]interface{})
 		consumerName, ok := consumer["name"].(string)
 		if !ok {
@@ -0,0 +1,107 @@
+package changefeed
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+type fakeTableSession struct {
+	tables  map[string]options.Description
+	queries []string
+}
+
+func (f *fakeTableSession) DescribeTable(_ context.Context, tablePath string, _ ...options.DescribeTableOption) (options.Description, error) {
+	description, ok := f.tables[tablePath]
+	if !ok {
+		return options.Description{}, fmt.Errorf("SCHEME_ERROR: path %q not found", tablePath)
+	}
+	return description, nil
+}
+
+func (f *fakeTableSession) ExecuteSchemeQuery(_ context.Context, query string, _ ...options.ExecuteSchemeQueryOption) error {
+	f.queries = append(f.queries, query)
+	return nil
+}
+
+func TestMoveChangefeed(t *testing.T) {
+	changefeed := options.ChangefeedDescription{
+		Name:   "changefeed",
+		Mode:   options.ChangefeedModeNewImage,
+		Format: options.ChangefeedFormatJSON,
+	}
+	createQuery := "ALTER TABLE `renamed` ADD CHANGEFEED `changefeed` WITH (\nMODE = \"NEW_IMAGE\",\nFORMAT = \"JSON\"\n)"
+	testData := []struct {
+		testName        string
+		tables          map[string]options.Description
+		protected       bool
+		expectedQueries []string
+		expectError     bool
+	}{
+		{
+			testName: "changefeed is moved with renamed table",
+			tables: map[string]options.Description{
+				"/local/renamed": {Changefeeds: []options.ChangefeedDescription{changefeed}},
+			},
+			protected: true,
+		},
+		{
+			testName: "changefeed is moved from the previous table",
+			tables: map[string]options.Description{
+				"/local/table":   {Changefeeds: []options.ChangefeedDescription{changefeed}},
+				"/local/renamed": {},
+			},
+			expectedQueries: []string{
+				"ALTER TABLE `table` DROP CHANGEFEED `changefeed`",
+				createQuery,
+			},
+		},
+		{
+			testName: "protected changefeed is moved from the previous table",
+			tables: map[string]options.Description{
+				"/local/table":   {Changefeeds: []options.ChangefeedDescription{changefeed}},
+				"/local/renamed": {},
+			},
+			protected:   true,
+			expectError: true,
+		},
+		{
+			testName: "changefeed is created when previous table is dropped",
+			tables: map[string]options.Description{
+				"/local/renamed": {},
+			},
+			protected:       true,
+			expectedQueries: []string{createQuery},
+		},
+		{
+			testName:    "table does not exist",
+			tables:      map[string]options.Description{},
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			s := &fakeTableSession{tables: v.tables}
+			format := "JSON"
+			cdc := &ChangeDataCaptureSettings{
+				TablePath: "renamed",
+				Name:      "changefeed",
+				Mode:      "NEW_IMAGE",
+				Format:    &format,
+			}
+			err := moveChangefeed(context.Background(), s, "/local", "table", cdc, v.protected)
+			if v.expectError {
+				assert.Error(t, err)
+				assert.Empty(t, s.queries)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, v.expectedQueries, s.queries)
+		})
+	}
+}
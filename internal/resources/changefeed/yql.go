@@ -2,7 +2,7 @@ package changefeed
 
 import "github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
 
-func PrepareCreateRequest(cdc *changeDataCaptureSettings) string {
+func PrepareCreateRequest(cdc *ChangeDataCaptureSettings) string {
 	buf := make([]byte, 0, 256)
 	buf = append(buf, "ALTER TABLE `"...)
 	buf = helpers.AppendWithEscape(buf, cdc.getTablePath())
@@ -0,0 +1,45 @@
+package directory
+
+import (
+	"context"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	directoryResource, err := directoryResourceSchemaToDirectoryResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: directoryResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize scheme client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	// NOTE: missing parent directories are created as well.
+	err = db.Scheme().MakeDirectory(ctx, path.Join(db.Name(), directoryResource.Path))
+	if err != nil {
+		return diag.Errorf("failed to create directory %q: %s", directoryResource.Path, err)
+	}
+
+	d.SetId(directoryResource.getConnectionString() + "?path=" + directoryResource.Path)
+
+	return h.Read(ctx, d, meta)
+}
@@ -0,0 +1,117 @@
+package directory
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+
+	tableresource "github.com/ydb-platform/terraform-provider-ydb/internal/resources/table"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+type schemeClient interface {
+	ListDirectory(ctx context.Context, path string) (scheme.Directory, error)
+	RemoveDirectory(ctx context.Context, path string) error
+}
+
+// dropEntryFunc drops scheme entry which is not a directory, path is relative to the database.
+type dropEntryFunc func(ctx context.Context, entryPath string, entryType scheme.EntryType) error
+
+func (h *handler) Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	directoryResource, err := directoryResourceSchemaToDirectoryResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: directoryResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize scheme client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	err = removeDirectory(ctx, db.Scheme(), dropEntry(db), db.Name(), directoryResource.Path, directoryResource.ForceDestroy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func dropEntry(db ydb.Connection) dropEntryFunc {
+	return func(ctx context.Context, entryPath string, entryType scheme.EntryType) error {
+		switch entryType {
+		case scheme.EntryTable, scheme.EntryColumnTable:
+			return db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+				return s.ExecuteSchemeQuery(ctx, tableresource.PrepareDropTableRequest(entryPath))
+			})
+		case scheme.EntryTopic:
+			return db.Topic().Drop(ctx, entryPath)
+		default:
+			return fmt.Errorf("removal of %s is not supported", entryType)
+		}
+	}
+}
+
+// removeDirectory removes the directory, dir is relative to the database. Non-empty
+// directory is removed only with recursive, its content is removed first.
+// NOTE: deletion_protection of the content is stored in state of other resources and is not checked.
+func removeDirectory(
+	ctx context.Context,
+	client schemeClient,
+	drop dropEntryFunc,
+	database string,
+	dir string,
+	recursive bool,
+) error {
+	directory, err := client.ListDirectory(ctx, path.Join(database, dir))
+	if err != nil {
+		return fmt.Errorf("failed to list directory %q: %w", dir, err)
+	}
+
+	if len(directory.Children) > 0 && !recursive {
+		names := make([]string, 0, len(directory.Children))
+		for _, v := range directory.Children {
+			names = append(names, v.Name)
+		}
+		return fmt.Errorf(
+			"directory %q is not empty: [%s], set force_destroy to remove it with its content",
+			dir,
+			strings.Join(names, ","),
+		)
+	}
+
+	for _, v := range directory.Children {
+		entryPath := path.Join(dir, v.Name)
+		if v.IsDirectory() {
+			err = removeDirectory(ctx, client, drop, database, entryPath, recursive)
+		} else {
+			err = drop(ctx, entryPath, v.Type)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to remove %q: %w", entryPath, err)
+		}
+	}
+
+	err = client.RemoveDirectory(ctx, path.Join(database, dir))
+	if err != nil {
+		return fmt.Errorf("failed to remove directory %q: %w", dir, err)
+	}
+	return nil
+}
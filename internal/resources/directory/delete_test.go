@@ -0,0 +1,102 @@
+package directory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+)
+
+type fakeSchemeClient struct {
+	directories map[string][]scheme.Entry
+	removed     []string
+}
+
+func (f *fakeSchemeClient) ListDirectory(_ context.Context, dir string) (scheme.Directory, error) {
+	children, ok := f.directories[dir]
+	if !ok {
+		return scheme.Directory{}, fmt.Errorf("path %q not found", dir)
+	}
+	return scheme.Directory{Children: children}, nil
+}
+
+func (f *fakeSchemeClient) RemoveDirectory(_ context.Context, dir string) error {
+	f.removed = append(f.removed, dir)
+	return nil
+}
+
+func TestRemoveDirectory(t *testing.T) {
+	directories := map[string][]scheme.Entry{
+		"/local/empty": {},
+		"/local/dir": {
+			{Name: "nested", Type: scheme.EntryDirectory},
+			{Name: "table", Type: scheme.EntryTable},
+		},
+		"/local/dir/nested": {
+			{Name: "topic", Type: scheme.EntryTopic},
+		},
+		"/local/node": {
+			{Name: "node", Type: scheme.EntryCoordinationNode},
+		},
+	}
+
+	testData := []struct {
+		testName        string
+		dir             string
+		recursive       bool
+		expectedRemoved []string
+		expectedDropped []string
+		expectError     bool
+	}{
+		{
+			testName:        "empty directory",
+			dir:             "empty",
+			expectedRemoved: []string{"/local/empty"},
+		},
+		{
+			testName:    "non-empty directory without force_destroy",
+			dir:         "dir",
+			expectError: true,
+		},
+		{
+			testName:        "non-empty directory with force_destroy",
+			dir:             "dir",
+			recursive:       true,
+			expectedRemoved: []string{"/local/dir/nested", "/local/dir"},
+			expectedDropped: []string{"dir/nested/topic:Topic", "dir/table:Table"},
+		},
+		{
+			testName:    "unsupported entry",
+			dir:         "node",
+			recursive:   true,
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			client := &fakeSchemeClient{directories: directories}
+			var dropped []string
+			drop := func(_ context.Context, entryPath string, entryType scheme.EntryType) error {
+				if entryType == scheme.EntryCoordinationNode {
+					return fmt.Errorf("removal of %s is not supported", entryType)
+				}
+				dropped = append(dropped, entryPath+":"+entryType.String())
+				return nil
+			}
+
+			err := removeDirectory(context.Background(), client, drop, "/local", v.dir, v.recursive)
+			if v.expectError {
+				assert.Error(t, err)
+				assert.Empty(t, client.removed)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, v.expectedRemoved, client.removed)
+			assert.Equal(t, v.expectedDropped, dropped)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package directory
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+type Resource struct {
+	Entity           *helpers.YDBEntity
+	DatabaseEndpoint string
+	Path             string
+	ForceDestroy     bool
+}
+
+func (r *Resource) getConnectionString() string {
+	if r.DatabaseEndpoint != "" {
+		return r.DatabaseEndpoint
+	}
+	return r.Entity.PrepareFullYDBEndpoint()
+}
+
+func directoryResourceSchemaToDirectoryResource(d *schema.ResourceData) (*Resource, error) {
+	var entity *helpers.YDBEntity
+	var err error
+	if d.Id() != "" {
+		entity, err = helpers.ParseYDBEntityID(d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse directory entity: %w", err)
+		}
+	}
+
+	r := &Resource{
+		Entity:           entity,
+		DatabaseEndpoint: d.Get("connection_string").(string),
+		Path:             strings.Trim(d.Get("path").(string), "/"),
+		ForceDestroy:     d.Get("force_destroy").(bool),
+	}
+	if entity != nil {
+		r.Path = entity.GetEntityPath()
+	}
+	return r, nil
+}
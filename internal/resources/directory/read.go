@@ -0,0 +1,59 @@
+package directory
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	directoryResource, err := directoryResourceSchemaToDirectoryResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: directoryResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize scheme client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	entry, err := db.Scheme().DescribePath(ctx, path.Join(db.Name(), directoryResource.Path))
+	if err != nil {
+		if strings.Contains(err.Error(), "SCHEME_ERROR") {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe directory %q: %s", directoryResource.Path, err)
+	}
+	if !entry.IsDirectory() {
+		return diag.Errorf("scheme entry %q is not a directory: %s", directoryResource.Path, entry.Type)
+	}
+
+	err = d.Set("path", directoryResource.Path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("connection_string", directoryResource.getConnectionString())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// NOTE: force_destroy is not stored in YDB, it is set explicitly for imported directories.
+	return diag.FromErr(d.Set("force_destroy", directoryResource.ForceDestroy))
+}
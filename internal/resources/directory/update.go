@@ -0,0 +1,14 @@
+package directory
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Update only refreshes the state: path and connection_string force replacement and
+// force_destroy is used on deletion only.
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return h.Read(ctx, d, meta)
+}
@@ -0,0 +1,46 @@
+package externaldatasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dataSourceResource, err := externalDataSourceResourceSchemaToResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: dataSourceResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize table client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		return s.ExecuteSchemeQuery(ctx, prepareCreateExternalDataSourceQuery(dataSourceResource))
+	})
+	if err != nil {
+		return diag.Errorf("failed to create external data source %q: %s", dataSourceResource.Path, err)
+	}
+
+	d.SetId(dataSourceResource.getConnectionString() + "?path=" + dataSourceResource.Path)
+
+	return h.Read(ctx, d, meta)
+}
@@ -0,0 +1,161 @@
+package externaldatasource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+const (
+	AuthMethodNone           = "NONE"
+	AuthMethodServiceAccount = "SERVICE_ACCOUNT"
+	AuthMethodAWS            = "AWS"
+	AuthMethodBasic          = "BASIC"
+)
+
+// authSettings maps attributes of the resource to settings of the data source.
+var authSettings = map[string]string{
+	"service_account_id":                "SERVICE_ACCOUNT_ID",
+	"service_account_secret_name":       "SERVICE_ACCOUNT_SECRET_NAME",
+	"aws_access_key_id_secret_name":     "AWS_ACCESS_KEY_ID_SECRET_NAME",
+	"aws_secret_access_key_secret_name": "AWS_SECRET_ACCESS_KEY_SECRET_NAME",
+	"aws_region":                        "AWS_REGION",
+	"login":                             "LOGIN",
+	"password_secret_name":              "PASSWORD_SECRET_NAME",
+}
+
+// authMethodAttributes are attributes required by auth methods, other auth attributes are not allowed.
+var authMethodAttributes = map[string][]string{
+	AuthMethodNone:           nil,
+	AuthMethodServiceAccount: {"service_account_id", "service_account_secret_name"},
+	AuthMethodAWS:            {"aws_access_key_id_secret_name", "aws_secret_access_key_secret_name", "aws_region"},
+	AuthMethodBasic:          {"login", "password_secret_name"},
+}
+
+var AuthMethods = []string{
+	AuthMethodNone,
+	AuthMethodServiceAccount,
+	AuthMethodAWS,
+	AuthMethodBasic,
+}
+
+// AuthAttributes are names of auth attributes of the resource.
+func AuthAttributes() []string {
+	attributes := make([]string, 0, len(authSettings))
+	for k := range authSettings {
+		attributes = append(attributes, k)
+	}
+	sort.Strings(attributes)
+	return attributes
+}
+
+type Auth struct {
+	Method string
+	// Attributes are non-empty auth attributes of the resource.
+	Attributes map[string]string
+}
+
+type Resource struct {
+	Entity           *helpers.YDBEntity
+	DatabaseEndpoint string
+	Path             string
+	SourceType       string
+	Location         string
+	Auth             Auth
+}
+
+func (r *Resource) getConnectionString() string {
+	if r.DatabaseEndpoint != "" {
+		return r.DatabaseEndpoint
+	}
+	return r.Entity.PrepareFullYDBEndpoint()
+}
+
+type resourceGetter interface {
+	Get(key string) interface{}
+}
+
+func expandAuth(d resourceGetter) Auth {
+	auth := Auth{
+		Method:     d.Get("auth_method").(string),
+		Attributes: make(map[string]string),
+	}
+	for k := range authSettings {
+		if v, _ := d.Get(k).(string); v != "" {
+			auth.Attributes[k] = v
+		}
+	}
+	return auth
+}
+
+func validateAuth(auth Auth) error {
+	required, ok := authMethodAttributes[auth.Method]
+	if !ok {
+		return fmt.Errorf("unknown auth_method %q", auth.Method)
+	}
+	allowed := make(map[string]struct{}, len(required))
+	var missing []string
+	for _, v := range required {
+		allowed[v] = struct{}{}
+		if _, ok := auth.Attributes[v]; !ok {
+			missing = append(missing, v)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("auth_method %q requires [%s] to be set", auth.Method, strings.Join(missing, ","))
+	}
+	var extra []string
+	for k := range auth.Attributes {
+		if _, ok := allowed[k]; !ok {
+			extra = append(extra, k)
+		}
+	}
+	if len(extra) > 0 {
+		sort.Strings(extra)
+		return fmt.Errorf("[%s] can not be used with auth_method %q", strings.Join(extra, ","), auth.Method)
+	}
+	return nil
+}
+
+func externalDataSourceResourceSchemaToResource(d *schema.ResourceData) (*Resource, error) {
+	var entity *helpers.YDBEntity
+	var err error
+	if d.Id() != "" {
+		entity, err = helpers.ParseYDBEntityID(d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse external data source entity: %w", err)
+		}
+	}
+
+	r := &Resource{
+		Entity:           entity,
+		DatabaseEndpoint: d.Get("connection_string").(string),
+		Path:             strings.Trim(d.Get("path").(string), "/"),
+		SourceType:       d.Get("source_type").(string),
+		Location:         d.Get("location").(string),
+		Auth:             expandAuth(d),
+	}
+	if entity != nil {
+		r.Path = entity.GetEntityPath()
+	}
+	return r, nil
+}
+
+// CustomizeDiff validates auth attributes against auth_method at plan time. Validation is
+// skipped while any of them is unknown, e.g. refers to a resource created in the same apply.
+func CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("auth_method") {
+		return nil
+	}
+	for k := range authSettings {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+	return validateAuth(expandAuth(d))
+}
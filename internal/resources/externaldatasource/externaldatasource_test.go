@@ -0,0 +1,136 @@
+package externaldatasource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAuth(t *testing.T) {
+	testData := []struct {
+		testName    string
+		auth        Auth
+		expectedErr string
+	}{
+		{
+			testName: "no auth",
+			auth:     Auth{Method: AuthMethodNone},
+		},
+		{
+			testName: "service account",
+			auth: Auth{
+				Method: AuthMethodServiceAccount,
+				Attributes: map[string]string{
+					"service_account_id":          "sa-id",
+					"service_account_secret_name": "sa_key",
+				},
+			},
+		},
+		{
+			testName: "missing attributes",
+			auth: Auth{
+				Method: AuthMethodAWS,
+				Attributes: map[string]string{
+					"aws_region": "us-east-1",
+				},
+			},
+			expectedErr: `auth_method "AWS" requires [aws_access_key_id_secret_name,aws_secret_access_key_secret_name] to be set`,
+		},
+		{
+			testName: "attributes of other method",
+			auth: Auth{
+				Method: AuthMethodNone,
+				Attributes: map[string]string{
+					"password_secret_name": "pg_password",
+					"login":                "reader",
+				},
+			},
+			expectedErr: `[login,password_secret_name] can not be used with auth_method "NONE"`,
+		},
+		{
+			testName:    "unknown method",
+			auth:        Auth{Method: "TOKEN"},
+			expectedErr: `unknown auth_method "TOKEN"`,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			err := validateAuth(v.auth)
+			if v.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, v.expectedErr)
+		})
+	}
+}
+
+// unknownValue is the value of unknown attributes in raw configuration.
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func TestCustomizeDiff(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"auth_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  AuthMethodNone,
+			},
+		},
+		CustomizeDiff: CustomizeDiff,
+	}
+	for _, v := range AuthAttributes() {
+		r.Schema[v] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+	}
+
+	testData := []struct {
+		testName    string
+		config      map[string]interface{}
+		expectedErr string
+	}{
+		{
+			testName: "service account is known",
+			config: map[string]interface{}{
+				"auth_method":                 AuthMethodServiceAccount,
+				"service_account_id":          "sa-id",
+				"service_account_secret_name": "sa_key",
+			},
+		},
+		{
+			testName: "service account is created in the same apply",
+			config: map[string]interface{}{
+				"auth_method":                 AuthMethodServiceAccount,
+				"service_account_id":          unknownValue,
+				"service_account_secret_name": "sa_key",
+			},
+		},
+		{
+			testName: "service account is missing",
+			config: map[string]interface{}{
+				"auth_method":                 AuthMethodServiceAccount,
+				"service_account_secret_name": "sa_key",
+			},
+			expectedErr: `auth_method "SERVICE_ACCOUNT" requires [service_account_id] to be set`,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(v.config), nil)
+			if v.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, v.expectedErr)
+		})
+	}
+}
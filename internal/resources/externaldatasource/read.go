@@ -0,0 +1,56 @@
+package externaldatasource
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/scheme"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dataSourceResource, err := externalDataSourceResourceSchemaToResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: dataSourceResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize table client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	entryType, err := scheme.DescribeEntryType(ctx, db, path.Join(db.Name(), dataSourceResource.Path))
+	if err != nil {
+		if strings.Contains(err.Error(), "SCHEME_ERROR") {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe external data source %q: %s", dataSourceResource.Path, err)
+	}
+	if entryType != scheme.EntryExternalDataSource {
+		return diag.Errorf("scheme entry %q is not an external data source: %s", dataSourceResource.Path, entryType)
+	}
+
+	// NOTE: settings of the external data source are not returned by DescribePath, they are kept from the state.
+	err = d.Set("path", dataSourceResource.Path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(d.Set("connection_string", dataSourceResource.getConnectionString()))
+}
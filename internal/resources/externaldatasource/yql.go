@@ -0,0 +1,38 @@
+package externaldatasource
+
+import (
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+func appendSetting(buf []byte, name, value string) []byte {
+	buf = append(buf, ",\n\t"...)
+	buf = append(buf, name...)
+	buf = append(buf, " = \""...)
+	buf = helpers.AppendStringWithEscape(buf, value)
+	buf = append(buf, '"')
+	return buf
+}
+
+func prepareCreateExternalDataSourceQuery(r *Resource) string {
+	buf := make([]byte, 0, 256)
+	buf = append(buf, "CREATE EXTERNAL DATA SOURCE `"...)
+	buf = helpers.AppendWithEscape(buf, r.Path)
+	buf = append(buf, "` WITH (\n\tSOURCE_TYPE = \""...)
+	buf = helpers.AppendStringWithEscape(buf, r.SourceType)
+	buf = append(buf, '"')
+	buf = appendSetting(buf, "LOCATION", r.Location)
+	buf = appendSetting(buf, "AUTH_METHOD", r.Auth.Method)
+	for _, v := range authMethodAttributes[r.Auth.Method] {
+		buf = appendSetting(buf, authSettings[v], r.Auth.Attributes[v])
+	}
+	buf = append(buf, "\n)"...)
+	return string(buf)
+}
+
+func prepareDropExternalDataSourceQuery(path string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "DROP EXTERNAL DATA SOURCE `"...)
+	buf = helpers.AppendWithEscape(buf, path)
+	buf = append(buf, '`')
+	return string(buf)
+}
@@ -0,0 +1,87 @@
+package externaldatasource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrepareCreateExternalDataSourceQuery(t *testing.T) {
+	testData := []struct {
+		testName string
+		resource *Resource
+		expected string
+	}{
+		{
+			testName: "no auth",
+			resource: &Resource{
+				Path:       "s3_data",
+				SourceType: "ObjectStorage",
+				Location:   "https://storage.yandexcloud.net/bucket/",
+				Auth:       Auth{Method: AuthMethodNone},
+			},
+			expected: "CREATE EXTERNAL DATA SOURCE `s3_data` WITH (\n" +
+				"\tSOURCE_TYPE = \"ObjectStorage\",\n" +
+				"\tLOCATION = \"https://storage.yandexcloud.net/bucket/\",\n" +
+				"\tAUTH_METHOD = \"NONE\"\n" +
+				")",
+		},
+		{
+			testName: "aws auth",
+			resource: &Resource{
+				Path:       "s3_data",
+				SourceType: "ObjectStorage",
+				Location:   "http://minio:9000/bucket/",
+				Auth: Auth{
+					Method: AuthMethodAWS,
+					Attributes: map[string]string{
+						"aws_access_key_id_secret_name":     "access_key",
+						"aws_secret_access_key_secret_name": "secret_key",
+						"aws_region":                        "us-east-1",
+					},
+				},
+			},
+			expected: "CREATE EXTERNAL DATA SOURCE `s3_data` WITH (\n" +
+				"\tSOURCE_TYPE = \"ObjectStorage\",\n" +
+				"\tLOCATION = \"http://minio:9000/bucket/\",\n" +
+				"\tAUTH_METHOD = \"AWS\",\n" +
+				"\tAWS_ACCESS_KEY_ID_SECRET_NAME = \"access_key\",\n" +
+				"\tAWS_SECRET_ACCESS_KEY_SECRET_NAME = \"secret_key\",\n" +
+				"\tAWS_REGION = \"us-east-1\"\n" +
+				")",
+		},
+		{
+			testName: "quotes are escaped",
+			resource: &Resource{
+				Path:       "pg",
+				SourceType: "PostgreSQL",
+				Location:   "host\"name",
+				Auth: Auth{
+					Method: AuthMethodBasic,
+					Attributes: map[string]string{
+						"login":                "reader",
+						"password_secret_name": "pg_password",
+					},
+				},
+			},
+			expected: "CREATE EXTERNAL DATA SOURCE `pg` WITH (\n" +
+				"\tSOURCE_TYPE = \"PostgreSQL\",\n" +
+				"\tLOCATION = \"host\\\"name\",\n" +
+				"\tAUTH_METHOD = \"BASIC\",\n" +
+				"\tLOGIN = \"reader\",\n" +
+				"\tPASSWORD_SECRET_NAME = \"pg_password\"\n" +
+				")",
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			assert.Equal(t, v.expected, prepareCreateExternalDataSourceQuery(v.resource))
+		})
+	}
+}
+
+func TestPrepareDropExternalDataSourceQuery(t *testing.T) {
+	assert.Equal(t, "DROP EXTERNAL DATA SOURCE `s3_data`", prepareDropExternalDataSourceQuery("s3_data"))
+}
@@ -0,0 +1,91 @@
+package externaltable
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/table"
+)
+
+var Formats = []string{
+	"csv_with_names",
+	"tsv_with_names",
+	"json_list",
+	"json_each_row",
+	"json_as_string",
+	"parquet",
+	"raw",
+}
+
+var Compressions = []string{
+	"gzip",
+	"zstd",
+	"lz4",
+	"brotli",
+	"bzip2",
+	"xz",
+}
+
+type Resource struct {
+	Entity           *helpers.YDBEntity
+	DatabaseEndpoint string
+	Path             string
+	DataSourcePath   string
+	Location         string
+	Format           string
+	Compression      string
+	Columns          []*table.Column
+}
+
+func (r *Resource) getConnectionString() string {
+	if r.DatabaseEndpoint != "" {
+		return r.DatabaseEndpoint
+	}
+	return r.Entity.PrepareFullYDBEndpoint()
+}
+
+func expandColumns(v interface{}) []*table.Column {
+	raw, _ := v.([]interface{})
+	columns := make([]*table.Column, 0, len(raw))
+	for _, c := range raw {
+		mp := c.(map[string]interface{})
+		column := &table.Column{
+			Name: mp["name"].(string),
+			Type: mp["type"].(string),
+		}
+		if notNull, ok := mp["not_null"].(bool); ok {
+			column.NotNull = notNull
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+func externalTableResourceSchemaToResource(d *schema.ResourceData) (*Resource, error) {
+	var entity *helpers.YDBEntity
+	var err error
+	if d.Id() != "" {
+		entity, err = helpers.ParseYDBEntityID(d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse external table entity: %w", err)
+		}
+	}
+
+	r := &Resource{
+		Entity:           entity,
+		DatabaseEndpoint: d.Get("connection_string").(string),
+		Path:             strings.Trim(d.Get("path").(string), "/"),
+		DataSourcePath:   strings.Trim(d.Get("data_source_path").(string), "/"),
+		Location:         d.Get("location").(string),
+		Format:           d.Get("format").(string),
+		Compression:      d.Get("compression").(string),
+		Columns:          expandColumns(d.Get("column")),
+	}
+	if entity != nil {
+		r.Path = entity.GetEntityPath()
+	}
+	return r, nil
+}
@@ -0,0 +1,56 @@
+package externaltable
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/scheme"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tableResource, err := externalTableResourceSchemaToResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: tableResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize table client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	entryType, err := scheme.DescribeEntryType(ctx, db, path.Join(db.Name(), tableResource.Path))
+	if err != nil {
+		if strings.Contains(err.Error(), "SCHEME_ERROR") {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe external table %q: %s", tableResource.Path, err)
+	}
+	if entryType != scheme.EntryExternalTable {
+		return diag.Errorf("scheme entry %q is not an external table: %s", tableResource.Path, entryType)
+	}
+
+	// NOTE: settings of the external table are not returned by DescribePath, they are kept from the state.
+	err = d.Set("path", tableResource.Path)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(d.Set("connection_string", tableResource.getConnectionString()))
+}
@@ -0,0 +1,13 @@
+package externaltable
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// NOTE: all arguments of external table force its recreation.
+	return h.Read(ctx, d, meta)
+}
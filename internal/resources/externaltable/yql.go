@@ -0,0 +1,49 @@
+package externaltable
+
+import (
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+func appendSetting(buf []byte, name, value string) []byte {
+	buf = append(buf, '\t')
+	buf = append(buf, name...)
+	buf = append(buf, " = \""...)
+	buf = helpers.AppendStringWithEscape(buf, value)
+	buf = append(buf, '"')
+	return buf
+}
+
+func prepareCreateExternalTableQuery(r *Resource) string {
+	buf := make([]byte, 0, 256)
+	buf = append(buf, "CREATE EXTERNAL TABLE `"...)
+	buf = helpers.AppendWithEscape(buf, r.Path)
+	buf = append(buf, "` (\n"...)
+	for i, v := range r.Columns {
+		buf = append(buf, '\t')
+		buf = append(buf, v.ToYQL()...)
+		if i != len(r.Columns)-1 {
+			buf = append(buf, ',')
+		}
+		buf = append(buf, '\n')
+	}
+	buf = append(buf, ") WITH (\n"...)
+	buf = appendSetting(buf, "DATA_SOURCE", r.DataSourcePath)
+	buf = append(buf, ",\n"...)
+	buf = appendSetting(buf, "LOCATION", r.Location)
+	buf = append(buf, ",\n"...)
+	buf = appendSetting(buf, "FORMAT", r.Format)
+	if r.Compression != "" {
+		buf = append(buf, ",\n"...)
+		buf = appendSetting(buf, "COMPRESSION", r.Compression)
+	}
+	buf = append(buf, "\n)"...)
+	return string(buf)
+}
+
+func prepareDropExternalTableQuery(path string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "DROP EXTERNAL TABLE `"...)
+	buf = helpers.AppendWithEscape(buf, path)
+	buf = append(buf, '`')
+	return string(buf)
+}
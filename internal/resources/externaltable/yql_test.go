@@ -0,0 +1,71 @@
+package externaltable
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/table"
+)
+
+func TestPrepareCreateExternalTableQuery(t *testing.T) {
+	testData := []struct {
+		testName string
+		resource *Resource
+		expected string
+	}{
+		{
+			testName: "without compression",
+			resource: &Resource{
+				Path:           "s3/events",
+				DataSourcePath: "s3_data",
+				Location:       "events/",
+				Format:         "csv_with_names",
+				Columns: []*table.Column{
+					{Name: "id", Type: "Uint64", NotNull: true},
+					{Name: "payload", Type: "Utf8"},
+				},
+			},
+			expected: "CREATE EXTERNAL TABLE `s3\\/events` (\n" +
+				"\t`id` Uint64 NOT NULL,\n" +
+				"\t`payload` Utf8\n" +
+				") WITH (\n" +
+				"\tDATA_SOURCE = \"s3_data\",\n" +
+				"\tLOCATION = \"events/\",\n" +
+				"\tFORMAT = \"csv_with_names\"\n" +
+				")",
+		},
+		{
+			testName: "with compression",
+			resource: &Resource{
+				Path:           "events",
+				DataSourcePath: "s3_data",
+				Location:       "events/",
+				Format:         "json_each_row",
+				Compression:    "gzip",
+				Columns: []*table.Column{
+					{Name: "id", Type: "Uint64", NotNull: true},
+				},
+			},
+			expected: "CREATE EXTERNAL TABLE `events` (\n" +
+				"\t`id` Uint64 NOT NULL\n" +
+				") WITH (\n" +
+				"\tDATA_SOURCE = \"s3_data\",\n" +
+				"\tLOCATION = \"events/\",\n" +
+				"\tFORMAT = \"json_each_row\",\n" +
+				"\tCOMPRESSION = \"gzip\"\n" +
+				")",
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			assert.Equal(t, v.expected, prepareCreateExternalTableQuery(v.resource))
+		})
+	}
+}
+
+func TestPrepareDropExternalTableQuery(t *testing.T) {
+	assert.Equal(t, "DROP EXTERNAL TABLE `events`", prepareDropExternalTableQuery("events"))
+}
@@ -0,0 +1,37 @@
+package group
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupResource, err := groupResourceSchemaToGroupResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: groupResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Errorf("failed to initialize table client: %s", err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		return s.ExecuteSchemeQuery(ctx, PrepareDropGroupQuery(groupResource.Name))
+	})
+	if err != nil {
+		return diag.Errorf("failed to drop group %q: %s", groupResource.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package group
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+type Resource struct {
+	Entity           *helpers.YDBEntity
+	DatabaseEndpoint string
+	Name             string
+}
+
+func (r *Resource) getConnectionString() string {
+	if r.DatabaseEndpoint != "" {
+		return r.DatabaseEndpoint
+	}
+	return r.Entity.PrepareFullYDBEndpoint()
+}
+
+func groupResourceSchemaToGroupResource(d *schema.ResourceData) (*Resource, error) {
+	var entity *helpers.YDBEntity
+	var err error
+	if d.Id() != "" {
+		entity, err = helpers.ParseYDBEntityID(d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse group entity: %w", err)
+		}
+	}
+
+	r := &Resource{
+		Entity:           entity,
+		DatabaseEndpoint: d.Get("connection_string").(string),
+		Name:             d.Get("name").(string),
+	}
+	if entity != nil {
+		r.Name = entity.GetEntityPath()
+	}
+	return r, nil
+}
+
+// groupExists looks up the group in .sys/auth_groups system view.
+func groupExists(ctx context.Context, db ydb.Connection, name string) (bool, error) {
+	exists := false
+	err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		res, err := s.StreamExecuteScanQuery(ctx, selectGroupQuery, table.NewQueryParameters(
+			table.ValueParam("$sid", types.TextValue(name)),
+		))
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+
+		exists = false
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var sid string
+				if err = res.ScanNamed(named.OptionalWithDefault("Sid", &sid)); err != nil {
+					return err
+				}
+				exists = exists || sid == name
+			}
+		}
+		return res.Err()
+	})
+	return exists, err
+}
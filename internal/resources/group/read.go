@@ -0,0 +1,49 @@
+package group
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupResource, err := groupResourceSchemaToGroupResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: groupResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize table client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	exists, err := groupExists(ctx, db, groupResource.Name)
+	if err != nil {
+		return diag.Errorf("failed to read group %q: %s", groupResource.Name, err)
+	}
+	if !exists {
+		d.SetId("")
+		return nil
+	}
+
+	err = d.Set("name", groupResource.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(d.Set("connection_string", groupResource.getConnectionString()))
+}
@@ -0,0 +1,50 @@
+package group
+
+import (
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+// AppendRoleName appends name of the user or group quoted as identifier.
+func AppendRoleName(buf []byte, name string) []byte {
+	buf = append(buf, '`')
+	buf = helpers.AppendWithEscape(buf, name)
+	buf = append(buf, '`')
+	return buf
+}
+
+func PrepareCreateGroupQuery(name string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "CREATE GROUP "...)
+	buf = AppendRoleName(buf, name)
+	return string(buf)
+}
+
+func PrepareDropGroupQuery(name string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "DROP GROUP "...)
+	buf = AppendRoleName(buf, name)
+	return string(buf)
+}
+
+// PrepareAddUserQuery adds the user into the group.
+func PrepareAddUserQuery(group, user string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "ALTER GROUP "...)
+	buf = AppendRoleName(buf, group)
+	buf = append(buf, " ADD USER "...)
+	buf = AppendRoleName(buf, user)
+	return string(buf)
+}
+
+// PrepareDropUserQuery removes the user from the group.
+func PrepareDropUserQuery(group, user string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "ALTER GROUP "...)
+	buf = AppendRoleName(buf, group)
+	buf = append(buf, " DROP USER "...)
+	buf = AppendRoleName(buf, user)
+	return string(buf)
+}
+
+const selectGroupQuery = `DECLARE $sid AS Utf8;
+SELECT Sid FROM ` + "`.sys/auth_groups`" + ` WHERE Sid = $sid;`
@@ -0,0 +1,43 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrepareGroupQueries(t *testing.T) {
+	testData := []struct {
+		testName string
+		query    string
+		expected string
+	}{
+		{
+			testName: "create group",
+			query:    PrepareCreateGroupQuery("readers"),
+			expected: "CREATE GROUP `readers`",
+		},
+		{
+			testName: "add user",
+			query:    PrepareAddUserQuery("readers", "robot"),
+			expected: "ALTER GROUP `readers` ADD USER `robot`",
+		},
+		{
+			testName: "drop user",
+			query:    PrepareDropUserQuery("readers", "robot"),
+			expected: "ALTER GROUP `readers` DROP USER `robot`",
+		},
+		{
+			testName: "drop group",
+			query:    PrepareDropGroupQuery("readers"),
+			expected: "DROP GROUP `readers`",
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			assert.Equal(t, v.expected, v.query)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package permissions
+
+import (
+	"context"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	permissionsResource, err := permissionsResourceSchemaToPermissionsResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: permissionsResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize scheme client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	actions, clearPermissions := prepareGrantActions(permissionsResource.Mode, grants{}, permissionsResource.Grants)
+	if permissionsResource.Owner != "" {
+		// NOTE: owner is changed last, new owner may be not granted to the caller.
+		actions = append(actions, changeOwnerAction(permissionsResource.Owner))
+	}
+	request := &Ydb_Scheme.ModifyPermissionsRequest{
+		Path:             path.Join(db.Name(), permissionsResource.Path),
+		Actions:          actions,
+		ClearPermissions: clearPermissions,
+	}
+	if permissionsResource.InterruptInheritance {
+		request.Inheritance = interruptInheritance(true)
+	}
+	err = modifyPermissions(ctx, db, request)
+	if err != nil {
+		return diag.Errorf("failed to modify permissions of %q: %s", permissionsResource.Path, err)
+	}
+
+	d.SetId(permissionsResource.getConnectionString() + "?path=" + permissionsResource.Path)
+
+	return h.Read(ctx, d, meta)
+}
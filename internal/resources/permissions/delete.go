@@ -0,0 +1,57 @@
+package permissions
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// Delete revokes permissions managed by the resource and restores inheritance. Only grants
+// from the state are revoked in both modes, permissions granted after the last refresh are kept.
+// NOTE: owner of the path is not restored.
+func (h *handler) Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	permissionsResource, err := permissionsResourceSchemaToPermissionsResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: permissionsResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize scheme client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	request := &Ydb_Scheme.ModifyPermissionsRequest{
+		Path: path.Join(db.Name(), permissionsResource.Path),
+	}
+	request.Actions, _ = prepareGrantActions(ModeAdditive, permissionsResource.Grants, grants{})
+	if permissionsResource.InterruptInheritance {
+		request.Inheritance = interruptInheritance(false)
+	}
+	err = modifyPermissions(ctx, db, request)
+	if err != nil {
+		if strings.Contains(err.Error(), "SCHEME_ERROR") {
+			// NOTE: path was removed, there is nothing to revoke.
+			return nil
+		}
+		return diag.Errorf("failed to revoke permissions of %q: %s", permissionsResource.Path, err)
+	}
+	return nil
+}
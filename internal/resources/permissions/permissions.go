@@ -0,0 +1,238 @@
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Scheme_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+const (
+	ModeAuthoritative = "authoritative"
+	ModeAdditive      = "additive"
+)
+
+// grants maps subjects to sorted permission names.
+type grants map[string][]string
+
+type Resource struct {
+	Entity               *helpers.YDBEntity
+	DatabaseEndpoint     string
+	Path                 string
+	Mode                 string
+	Owner                string
+	Grants               grants
+	InterruptInheritance bool
+}
+
+func (r *Resource) getConnectionString() string {
+	if r.DatabaseEndpoint != "" {
+		return r.DatabaseEndpoint
+	}
+	return r.Entity.PrepareFullYDBEndpoint()
+}
+
+func permissionsResourceSchemaToPermissionsResource(d *schema.ResourceData) (*Resource, error) {
+	var entity *helpers.YDBEntity
+	var err error
+	if d.Id() != "" {
+		entity, err = helpers.ParseYDBEntityID(d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse permissions entity: %w", err)
+		}
+	}
+
+	r := &Resource{
+		Entity:               entity,
+		DatabaseEndpoint:     d.Get("connection_string").(string),
+		Path:                 strings.Trim(d.Get("path").(string), "/"),
+		Mode:                 d.Get("mode").(string),
+		Owner:                d.Get("owner").(string),
+		Grants:               expandGrants(d.Get("grant")),
+		InterruptInheritance: d.Get("interrupt_inheritance").(bool),
+	}
+	if entity != nil {
+		r.Path = entity.GetEntityPath()
+	}
+	if r.Mode == "" {
+		// NOTE: mode is not set for imported resources.
+		r.Mode = ModeAuthoritative
+	}
+	return r, nil
+}
+
+func expandGrants(v interface{}) grants {
+	result := make(grants)
+	set, ok := v.(*schema.Set)
+	if !ok {
+		return result
+	}
+	for _, g := range set.List() {
+		mp := g.(map[string]interface{})
+		subject := mp["subject"].(string)
+		if names, ok := mp["permissions"].(*schema.Set); ok {
+			for _, name := range names.List() {
+				result[subject] = append(result[subject], name.(string))
+			}
+		}
+		sort.Strings(result[subject])
+	}
+	return result
+}
+
+// explicitGrants merges explicit ACL entries of the path by subjects.
+func explicitGrants(permissions []scheme.Permissions) grants {
+	result := make(grants)
+	for _, v := range permissions {
+		result[v.Subject] = append(result[v.Subject], v.PermissionNames...)
+	}
+	for subject, names := range result {
+		result[subject] = uniqueSorted(names)
+	}
+	return result
+}
+
+func uniqueSorted(names []string) []string {
+	sort.Strings(names)
+	result := names[:0]
+	for i, v := range names {
+		if i > 0 && v == names[i-1] {
+			continue
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// difference returns names from a which are missing in b.
+func difference(a, b []string) []string {
+	mp := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		mp[v] = struct{}{}
+	}
+	var result []string
+	for _, v := range a {
+		if _, ok := mp[v]; !ok {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// intersection returns names from a which are present in b.
+func intersection(a, b []string) []string {
+	return difference(a, difference(a, b))
+}
+
+func sortedSubjects(g grants) []string {
+	subjects := make([]string, 0, len(g))
+	for k := range g {
+		subjects = append(subjects, k)
+	}
+	sort.Strings(subjects)
+	return subjects
+}
+
+// prepareGrantActions returns actions which bring grants from old to new. In authoritative
+// mode all explicit permissions are cleared and new ones are granted, in additive mode only
+// permissions removed from the resource are revoked.
+func prepareGrantActions(mode string, oldGrants, newGrants grants) (actions []*Ydb_Scheme.PermissionsAction, clearPermissions bool) {
+	if mode == ModeAdditive {
+		for _, subject := range sortedSubjects(oldGrants) {
+			revoked := difference(oldGrants[subject], newGrants[subject])
+			if len(revoked) == 0 {
+				continue
+			}
+			actions = append(actions, &Ydb_Scheme.PermissionsAction{
+				Action: &Ydb_Scheme.PermissionsAction_Revoke{
+					Revoke: &Ydb_Scheme.Permissions{
+						Subject:         subject,
+						PermissionNames: revoked,
+					},
+				},
+			})
+		}
+	} else {
+		clearPermissions = true
+	}
+
+	for _, subject := range sortedSubjects(newGrants) {
+		if len(newGrants[subject]) == 0 {
+			continue
+		}
+		actions = append(actions, &Ydb_Scheme.PermissionsAction{
+			Action: &Ydb_Scheme.PermissionsAction_Grant{
+				Grant: &Ydb_Scheme.Permissions{
+					Subject:         subject,
+					PermissionNames: newGrants[subject],
+				},
+			},
+		})
+	}
+	return actions, clearPermissions
+}
+
+func changeOwnerAction(owner string) *Ydb_Scheme.PermissionsAction {
+	return &Ydb_Scheme.PermissionsAction{
+		Action: &Ydb_Scheme.PermissionsAction_ChangeOwner{
+			ChangeOwner: owner,
+		},
+	}
+}
+
+func interruptInheritance(interrupt bool) *Ydb_Scheme.ModifyPermissionsRequest_InterruptInheritance {
+	return &Ydb_Scheme.ModifyPermissionsRequest_InterruptInheritance{
+		InterruptInheritance: interrupt,
+	}
+}
+
+// modifyPermissions calls the scheme service directly: scheme client of the SDK does not
+// support interruption of permissions inheritance.
+func modifyPermissions(ctx context.Context, db ydb.Connection, request *Ydb_Scheme.ModifyPermissionsRequest) error {
+	if len(request.Actions) == 0 && !request.ClearPermissions && request.Inheritance == nil {
+		return nil
+	}
+	client := Ydb_Scheme_V1.NewSchemeServiceClient(ydb.GRPCConn(db))
+	response, err := client.ModifyPermissions(ctx, request)
+	if err != nil {
+		return err
+	}
+	operation := response.GetOperation()
+	if operation.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return fmt.Errorf("operation failed with status %s: %v", operation.GetStatus(), operation.GetIssues())
+	}
+	return nil
+}
+
+// flattenGrants returns grants to be stored in the state. In additive mode only permissions
+// declared in the resource are kept, grants made outside of the resource are ignored.
+func flattenGrants(mode string, declared, explicit grants) []interface{} {
+	result := make([]interface{}, 0, len(explicit))
+	for _, subject := range sortedSubjects(explicit) {
+		names := explicit[subject]
+		if mode == ModeAdditive {
+			names = intersection(names, declared[subject])
+		}
+		if len(names) == 0 {
+			continue
+		}
+		flattened := make([]interface{}, 0, len(names))
+		for _, v := range names {
+			flattened = append(flattened, v)
+		}
+		result = append(result, map[string]interface{}{
+			"subject":     subject,
+			"permissions": flattened,
+		})
+	}
+	return result
+}
@@ -0,0 +1,135 @@
+package permissions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+)
+
+func grantAction(subject string, names ...string) *Ydb_Scheme.PermissionsAction {
+	return &Ydb_Scheme.PermissionsAction{
+		Action: &Ydb_Scheme.PermissionsAction_Grant{
+			Grant: &Ydb_Scheme.Permissions{Subject: subject, PermissionNames: names},
+		},
+	}
+}
+
+func revokeAction(subject string, names ...string) *Ydb_Scheme.PermissionsAction {
+	return &Ydb_Scheme.PermissionsAction{
+		Action: &Ydb_Scheme.PermissionsAction_Revoke{
+			Revoke: &Ydb_Scheme.Permissions{Subject: subject, PermissionNames: names},
+		},
+	}
+}
+
+func TestPrepareGrantActions(t *testing.T) {
+	testData := []struct {
+		testName        string
+		mode            string
+		oldGrants       grants
+		newGrants       grants
+		expectedActions []*Ydb_Scheme.PermissionsAction
+		expectedClear   bool
+	}{
+		{
+			testName: "authoritative clears and grants",
+			mode:     ModeAuthoritative,
+			oldGrants: grants{
+				"reader": {"ydb.generic.read"},
+			},
+			newGrants: grants{
+				"writer": {"ydb.generic.read", "ydb.generic.write"},
+				"admin":  {"ydb.generic.full"},
+			},
+			expectedActions: []*Ydb_Scheme.PermissionsAction{
+				grantAction("admin", "ydb.generic.full"),
+				grantAction("writer", "ydb.generic.read", "ydb.generic.write"),
+			},
+			expectedClear: true,
+		},
+		{
+			testName:      "authoritative with no grants clears",
+			mode:          ModeAuthoritative,
+			oldGrants:     grants{"reader": {"ydb.generic.read"}},
+			newGrants:     grants{},
+			expectedClear: true,
+		},
+		{
+			testName: "additive revokes removed permissions only",
+			mode:     ModeAdditive,
+			oldGrants: grants{
+				"reader": {"ydb.generic.list", "ydb.generic.read"},
+				"writer": {"ydb.generic.write"},
+			},
+			newGrants: grants{
+				"reader": {"ydb.generic.read"},
+			},
+			expectedActions: []*Ydb_Scheme.PermissionsAction{
+				revokeAction("reader", "ydb.generic.list"),
+				revokeAction("writer", "ydb.generic.write"),
+				grantAction("reader", "ydb.generic.read"),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			actions, clearPermissions := prepareGrantActions(v.mode, v.oldGrants, v.newGrants)
+			assert.Equal(t, v.expectedActions, actions)
+			assert.Equal(t, v.expectedClear, clearPermissions)
+		})
+	}
+}
+
+func TestFlattenGrants(t *testing.T) {
+	explicit := explicitGrants([]scheme.Permissions{
+		{Subject: "reader", PermissionNames: []string{"ydb.generic.read"}},
+		{Subject: "manual", PermissionNames: []string{"ydb.generic.full"}},
+		{Subject: "reader", PermissionNames: []string{"ydb.generic.list", "ydb.generic.read"}},
+	})
+	declared := grants{
+		"reader": {"ydb.generic.read"},
+		"writer": {"ydb.generic.write"},
+	}
+
+	testData := []struct {
+		testName string
+		mode     string
+		expected []interface{}
+	}{
+		{
+			testName: "authoritative returns all explicit permissions",
+			mode:     ModeAuthoritative,
+			expected: []interface{}{
+				map[string]interface{}{
+					"subject":     "manual",
+					"permissions": []interface{}{"ydb.generic.full"},
+				},
+				map[string]interface{}{
+					"subject":     "reader",
+					"permissions": []interface{}{"ydb.generic.list", "ydb.generic.read"},
+				},
+			},
+		},
+		{
+			testName: "additive returns declared permissions which are granted",
+			mode:     ModeAdditive,
+			expected: []interface{}{
+				map[string]interface{}{
+					"subject":     "reader",
+					"permissions": []interface{}{"ydb.generic.read"},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			assert.Equal(t, v.expected, flattenGrants(v.mode, declared, explicit))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package permissions
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	permissionsResource, err := permissionsResourceSchemaToPermissionsResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: permissionsResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize scheme client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	entry, err := db.Scheme().DescribePath(ctx, path.Join(db.Name(), permissionsResource.Path))
+	if err != nil {
+		if strings.Contains(err.Error(), "SCHEME_ERROR") {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe %q: %s", permissionsResource.Path, err)
+	}
+
+	values := map[string]interface{}{
+		"path":              permissionsResource.Path,
+		"connection_string": permissionsResource.getConnectionString(),
+		"mode":              permissionsResource.Mode,
+		"owner":             entry.Owner,
+		"grant": flattenGrants(
+			permissionsResource.Mode,
+			permissionsResource.Grants,
+			explicitGrants(entry.Permissions),
+		),
+		// NOTE: inheritance is not returned by DescribePath, it is kept from the state.
+		"interrupt_inheritance": permissionsResource.InterruptInheritance,
+	}
+	for k, v := range values {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
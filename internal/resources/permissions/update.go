@@ -0,0 +1,60 @@
+package permissions
+
+import (
+	"context"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	permissionsResource, err := permissionsResourceSchemaToPermissionsResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: permissionsResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize scheme client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	request := &Ydb_Scheme.ModifyPermissionsRequest{
+		Path: path.Join(db.Name(), permissionsResource.Path),
+	}
+	if d.HasChanges("grant", "mode") {
+		o, n := d.GetChange("grant")
+		request.Actions, request.ClearPermissions = prepareGrantActions(
+			permissionsResource.Mode,
+			expandGrants(o),
+			expandGrants(n),
+		)
+	}
+	if d.HasChange("owner") && permissionsResource.Owner != "" {
+		request.Actions = append(request.Actions, changeOwnerAction(permissionsResource.Owner))
+	}
+	if d.HasChange("interrupt_inheritance") {
+		request.Inheritance = interruptInheritance(permissionsResource.InterruptInheritance)
+	}
+	err = modifyPermissions(ctx, db, request)
+	if err != nil {
+		return diag.Errorf("failed to modify permissions of %q: %s", permissionsResource.Path, err)
+	}
+
+	return h.Read(ctx, d, meta)
+}
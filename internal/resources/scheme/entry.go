@@ -0,0 +1,162 @@
+package scheme
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Scheme_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// ReadEntryDataSource describes any scheme entry by path.
+func ReadEntryDataSource(ctx context.Context, d *schema.ResourceData, params DataSourceParams) diag.Diagnostics {
+	connectionString, err := helpers.DataSourceConnectionString(d, params.DefaultConnectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: connectionString,
+		Token:            params.Token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize scheme client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	entryPath := strings.Trim(d.Get("path").(string), "/")
+	entry, err := describePath(ctx, db, path.Join(db.Name(), entryPath))
+	if err != nil {
+		if strings.Contains(err.Error(), "SCHEME_ERROR") {
+			return diag.Errorf("scheme entry %q does not exist", entryPath)
+		}
+		return diag.Errorf("failed to describe scheme entry %q: %s", entryPath, err)
+	}
+
+	err = d.Set("connection_string", connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = flattenEntry(d, entry)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(connectionString + "?path=" + entryPath)
+	return nil
+}
+
+// DescribeEntryType returns type of the entry as it is reported by the server, types which are
+// not known to the SDK (e.g. EntryView) are reported by the SDK as unknown.
+func DescribeEntryType(ctx context.Context, db ydb.Connection, fullPath string) (Ydb_Scheme.Entry_Type, error) {
+	entry, err := describePath(ctx, db, fullPath)
+	if err != nil {
+		return Ydb_Scheme.Entry_TYPE_UNSPECIFIED, err
+	}
+	return entry.GetType(), nil
+}
+
+// describePath describes the path with the scheme service directly: scheme client of the SDK
+// does not return the creation step of the entry and types of entries unknown to it.
+func describePath(ctx context.Context, db ydb.Connection, fullPath string) (*Ydb_Scheme.Entry, error) {
+	client := Ydb_Scheme_V1.NewSchemeServiceClient(ydb.GRPCConn(db))
+	response, err := client.DescribePath(ctx, &Ydb_Scheme.DescribePathRequest{
+		Path: fullPath,
+	})
+	if err != nil {
+		return nil, err
+	}
+	operation := response.GetOperation()
+	if operation.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return nil, fmt.Errorf("operation failed with status %s: %v", operation.GetStatus(), operation.GetIssues())
+	}
+	var result Ydb_Scheme.DescribePathResult
+	err = operation.GetResult().UnmarshalTo(&result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal describe path result: %w", err)
+	}
+	return result.GetSelf(), nil
+}
+
+func flattenEntry(d *schema.ResourceData, entry *Ydb_Scheme.Entry) error {
+	converted := scheme.InnerConvertEntry(entry)
+	values := map[string]interface{}{
+		"name":                  entry.GetName(),
+		"type":                  entryTypeName(entry.GetType()),
+		"owner":                 entry.GetOwner(),
+		"permissions":           flattenPermissions(converted.Permissions),
+		"effective_permissions": flattenPermissions(converted.EffectivePermissions),
+		"created_at_plan_step":  int(entry.GetCreatedAt().GetPlanStep()),
+		"created_at_tx_id":      int(entry.GetCreatedAt().GetTxId()),
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func flattenPermissions(permissions []scheme.Permissions) []interface{} {
+	result := make([]interface{}, 0, len(permissions))
+	for _, v := range permissions {
+		names := make([]interface{}, 0, len(v.PermissionNames))
+		for _, name := range v.PermissionNames {
+			names = append(names, name)
+		}
+		result = append(result, map[string]interface{}{
+			"subject":          v.Subject,
+			"permission_names": names,
+		})
+	}
+	return result
+}
+
+// schemeService lists directories with the scheme service directly: scheme client of the SDK
+// does not return types of entries unknown to it.
+type schemeService struct {
+	client Ydb_Scheme_V1.SchemeServiceClient
+}
+
+func newSchemeService(db ydb.Connection) *schemeService {
+	return &schemeService{
+		client: Ydb_Scheme_V1.NewSchemeServiceClient(ydb.GRPCConn(db)),
+	}
+}
+
+func (s *schemeService) ListDirectory(ctx context.Context, fullPath string) ([]*Ydb_Scheme.Entry, error) {
+	response, err := s.client.ListDirectory(ctx, &Ydb_Scheme.ListDirectoryRequest{
+		Path: fullPath,
+	})
+	if err != nil {
+		return nil, err
+	}
+	operation := response.GetOperation()
+	if operation.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return nil, fmt.Errorf("operation failed with status %s: %v", operation.GetStatus(), operation.GetIssues())
+	}
+	var result Ydb_Scheme.ListDirectoryResult
+	err = operation.GetResult().UnmarshalTo(&result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal list directory result: %w", err)
+	}
+	return result.GetChildren(), nil
+}
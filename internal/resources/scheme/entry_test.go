@@ -0,0 +1,61 @@
+package scheme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+)
+
+func TestFlattenPermissions(t *testing.T) {
+	testData := []struct {
+		testName    string
+		permissions []scheme.Permissions
+		expected    []interface{}
+	}{
+		{
+			testName: "no permissions",
+			expected: []interface{}{},
+		},
+		{
+			testName: "several subjects",
+			permissions: []scheme.Permissions{
+				{
+					Subject:         "admins",
+					PermissionNames: []string{"ydb.generic.full"},
+				},
+				{
+					Subject:         "reader@builtin",
+					PermissionNames: []string{"ydb.generic.read", "ydb.generic.list"},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"subject":          "admins",
+					"permission_names": []interface{}{"ydb.generic.full"},
+				},
+				map[string]interface{}{
+					"subject":          "reader@builtin",
+					"permission_names": []interface{}{"ydb.generic.read", "ydb.generic.list"},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			assert.Equal(t, v.expected, flattenPermissions(v.permissions))
+		})
+	}
+}
+
+func TestEntryTypeName(t *testing.T) {
+	assert.Equal(t, "coordination_node", entryTypeName(Ydb_Scheme.Entry_COORDINATION_NODE))
+	assert.Equal(t, EntryTypeColumnTable, entryTypeName(Ydb_Scheme.Entry_COLUMN_TABLE))
+	assert.Equal(t, EntryTypeView, entryTypeName(EntryView))
+	assert.Equal(t, EntryTypeExternalTable, entryTypeName(EntryExternalTable))
+	assert.Equal(t, EntryTypeExternalDataSource, entryTypeName(EntryExternalDataSource))
+	assert.Equal(t, "unknown", entryTypeName(Ydb_Scheme.Entry_Type(100)))
+}
@@ -0,0 +1,174 @@
+package scheme
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+type listedEntry struct {
+	Path string
+	Type Ydb_Scheme.Entry_Type
+}
+
+type directoryLister interface {
+	ListDirectory(ctx context.Context, path string) ([]*Ydb_Scheme.Entry, error)
+}
+
+// ReadListDataSource lists scheme entries under the directory, optionally recursively.
+func ReadListDataSource(ctx context.Context, d *schema.ResourceData, params DataSourceParams) diag.Diagnostics {
+	connectionString, err := helpers.DataSourceConnectionString(d, params.DefaultConnectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v := d.Get("name_regex").(string); v != "" {
+		nameRegex, err = regexp.Compile(v)
+		if err != nil {
+			return diag.Errorf("failed to compile name_regex: %s", err)
+		}
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: connectionString,
+		Token:            params.Token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize scheme client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	dir := strings.Trim(d.Get("path").(string), "/")
+	entries, err := listDirectory(ctx, newSchemeService(db), db.Name(), dir, d.Get("recursive").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	entries = filterEntries(entries, expandEntryTypes(d.Get("types")), nameRegex)
+
+	err = d.Set("connection_string", connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = flattenListedEntries(d, connectionString, entries)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(connectionString + "?path=" + dir)
+	return nil
+}
+
+// listDirectory lists entries under dir, paths of entries are relative to the database.
+// NOTE: system directories, e.g. .sys, are skipped.
+func listDirectory(
+	ctx context.Context,
+	client directoryLister,
+	database string,
+	dir string,
+	recursive bool,
+) ([]listedEntry, error) {
+	children, err := client.ListDirectory(ctx, path.Join(database, dir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list directory %q: %w", dir, err)
+	}
+
+	var result []listedEntry
+	for _, child := range children {
+		if strings.HasPrefix(child.GetName(), ".") {
+			continue
+		}
+		entryPath := path.Join(dir, child.GetName())
+		result = append(result, listedEntry{
+			Path: entryPath,
+			Type: child.GetType(),
+		})
+		if !recursive || child.GetType() != Ydb_Scheme.Entry_DIRECTORY {
+			continue
+		}
+		nested, err := listDirectory(ctx, client, database, entryPath, recursive)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, nested...)
+	}
+	return result, nil
+}
+
+// expandEntryTypes returns entry types to filter by, row and column tables are listed by default.
+func expandEntryTypes(v interface{}) map[string]struct{} {
+	types := make(map[string]struct{})
+	if set, ok := v.(*schema.Set); ok {
+		for _, t := range set.List() {
+			types[t.(string)] = struct{}{}
+		}
+	}
+	if len(types) == 0 {
+		types[EntryTypeTable] = struct{}{}
+		types[EntryTypeColumnTable] = struct{}{}
+	}
+	return types
+}
+
+// filterEntries filters entries by type and by name (the last element of the path).
+func filterEntries(entries []listedEntry, types map[string]struct{}, nameRegex *regexp.Regexp) []listedEntry {
+	result := make([]listedEntry, 0, len(entries))
+	for _, v := range entries {
+		if _, ok := types[entryTypeName(v.Type)]; !ok {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(path.Base(v.Path)) {
+			continue
+		}
+		result = append(result, v)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+	return result
+}
+
+func flattenListedEntries(d *schema.ResourceData, connectionString string, entries []listedEntry) error {
+	flattened := make([]interface{}, 0, len(entries))
+	paths := make([]interface{}, 0, len(entries))
+	ids := make([]interface{}, 0, len(entries))
+	for _, v := range entries {
+		id := connectionString + "?path=" + v.Path
+		flattened = append(flattened, map[string]interface{}{
+			"name": path.Base(v.Path),
+			"path": v.Path,
+			"type": entryTypeName(v.Type),
+			"id":   id,
+		})
+		paths = append(paths, v.Path)
+		ids = append(ids, id)
+	}
+
+	err := d.Set("entries", flattened)
+	if err != nil {
+		return err
+	}
+	err = d.Set("paths", paths)
+	if err != nil {
+		return err
+	}
+	return d.Set("ids", ids)
+}
@@ -0,0 +1,144 @@
+package scheme
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+)
+
+type fakeLister map[string][]*Ydb_Scheme.Entry
+
+func (f fakeLister) ListDirectory(_ context.Context, path string) ([]*Ydb_Scheme.Entry, error) {
+	children, ok := f[path]
+	if !ok {
+		return nil, fmt.Errorf("path %q not found", path)
+	}
+	return children, nil
+}
+
+func TestListDirectory(t *testing.T) {
+	lister := fakeLister{
+		"/local": {
+			{Name: ".sys", Type: Ydb_Scheme.Entry_DIRECTORY},
+			{Name: "dir", Type: Ydb_Scheme.Entry_DIRECTORY},
+			{Name: "users", Type: Ydb_Scheme.Entry_TABLE},
+		},
+		"/local/dir": {
+			{Name: "events", Type: Ydb_Scheme.Entry_COLUMN_TABLE},
+			{Name: "queue", Type: Ydb_Scheme.Entry_TOPIC},
+			{Name: "recent", Type: EntryView},
+		},
+	}
+
+	testData := []struct {
+		testName  string
+		dir       string
+		recursive bool
+		expected  []listedEntry
+	}{
+		{
+			testName: "database root",
+			expected: []listedEntry{
+				{Path: "dir", Type: Ydb_Scheme.Entry_DIRECTORY},
+				{Path: "users", Type: Ydb_Scheme.Entry_TABLE},
+			},
+		},
+		{
+			testName:  "database root recursively",
+			recursive: true,
+			expected: []listedEntry{
+				{Path: "dir", Type: Ydb_Scheme.Entry_DIRECTORY},
+				{Path: "dir/events", Type: Ydb_Scheme.Entry_COLUMN_TABLE},
+				{Path: "dir/queue", Type: Ydb_Scheme.Entry_TOPIC},
+				{Path: "dir/recent", Type: EntryView},
+				{Path: "users", Type: Ydb_Scheme.Entry_TABLE},
+			},
+		},
+		{
+			testName: "nested directory",
+			dir:      "dir",
+			expected: []listedEntry{
+				{Path: "dir/events", Type: Ydb_Scheme.Entry_COLUMN_TABLE},
+				{Path: "dir/queue", Type: Ydb_Scheme.Entry_TOPIC},
+				{Path: "dir/recent", Type: EntryView},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			got, err := listDirectory(context.Background(), lister, "/local", v.dir, v.recursive)
+			assert.NoError(t, err)
+			assert.Equal(t, v.expected, got)
+		})
+	}
+
+	_, err := listDirectory(context.Background(), lister, "/local", "missing", false)
+	assert.Error(t, err)
+}
+
+func TestFilterEntries(t *testing.T) {
+	entries := []listedEntry{
+		{Path: "users", Type: Ydb_Scheme.Entry_TABLE},
+		{Path: "dir", Type: Ydb_Scheme.Entry_DIRECTORY},
+		{Path: "dir/events", Type: Ydb_Scheme.Entry_COLUMN_TABLE},
+		{Path: "dir/queue", Type: Ydb_Scheme.Entry_TOPIC},
+		{Path: "dir/users_archive", Type: Ydb_Scheme.Entry_TABLE},
+		{Path: "dir/recent", Type: EntryView},
+	}
+
+	testData := []struct {
+		testName  string
+		types     []string
+		nameRegex *regexp.Regexp
+		expected  []string
+	}{
+		{
+			testName: "tables by default",
+			expected: []string{"dir/events", "dir/users_archive", "users"},
+		},
+		{
+			testName: "topics and directories",
+			types:    []string{EntryTypeTopic, EntryTypeDirectory},
+			expected: []string{"dir", "dir/queue"},
+		},
+		{
+			testName: "views",
+			types:    []string{EntryTypeView},
+			expected: []string{"dir/recent"},
+		},
+		{
+			testName:  "name regex matches last path element",
+			nameRegex: regexp.MustCompile("^users"),
+			expected:  []string{"dir/users_archive", "users"},
+		},
+		{
+			testName:  "nothing matches",
+			types:     []string{EntryTypeTopic},
+			nameRegex: regexp.MustCompile("^users"),
+			expected:  []string{},
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			types := make([]interface{}, 0, len(v.types))
+			for _, typ := range v.types {
+				types = append(types, typ)
+			}
+			got := filterEntries(entries, expandEntryTypes(schema.NewSet(schema.HashString, types)), v.nameRegex)
+			paths := make([]string, 0, len(got))
+			for _, e := range got {
+				paths = append(paths, e.Path)
+			}
+			assert.Equal(t, v.expected, paths)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package scheme
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+)
+
+const (
+	EntryTypeDirectory   = "directory"
+	EntryTypeTable       = "table"
+	EntryTypeColumnTable = "column_table"
+	EntryTypeTopic       = "topic"
+
+	EntryTypeExternalTable      = "external_table"
+	EntryTypeExternalDataSource = "external_data_source"
+	EntryTypeView               = "view"
+)
+
+// Entry types which are not known to the SDK yet, values are from ydb_scheme.proto.
+const (
+	EntryExternalTable      Ydb_Scheme.Entry_Type = 18
+	EntryExternalDataSource Ydb_Scheme.Entry_Type = 19
+	EntryView               Ydb_Scheme.Entry_Type = 20
+)
+
+var entryTypeNames = map[Ydb_Scheme.Entry_Type]string{
+	Ydb_Scheme.Entry_TYPE_UNSPECIFIED:   "unknown",
+	Ydb_Scheme.Entry_DIRECTORY:          EntryTypeDirectory,
+	Ydb_Scheme.Entry_TABLE:              EntryTypeTable,
+	Ydb_Scheme.Entry_PERS_QUEUE_GROUP:   "pers_queue_group",
+	Ydb_Scheme.Entry_DATABASE:           "database",
+	Ydb_Scheme.Entry_RTMR_VOLUME:        "rtmr_volume",
+	Ydb_Scheme.Entry_BLOCK_STORE_VOLUME: "block_store_volume",
+	Ydb_Scheme.Entry_COORDINATION_NODE:  "coordination_node",
+	Ydb_Scheme.Entry_TOPIC:              EntryTypeTopic,
+	Ydb_Scheme.Entry_COLUMN_STORE:       "column_store",
+	Ydb_Scheme.Entry_COLUMN_TABLE:       EntryTypeColumnTable,
+	Ydb_Scheme.Entry_SEQUENCE:           "sequence",
+	Ydb_Scheme.Entry_REPLICATION:        "replication",
+	EntryExternalTable:                  EntryTypeExternalTable,
+	EntryExternalDataSource:             EntryTypeExternalDataSource,
+	EntryView:                           EntryTypeView,
+}
+
+// ListableEntryTypes are entry types which ydb_tables data source can be filtered by.
+var ListableEntryTypes = []string{
+	EntryTypeDirectory,
+	EntryTypeTable,
+	EntryTypeColumnTable,
+	EntryTypeTopic,
+	EntryTypeView,
+	EntryTypeExternalTable,
+	EntryTypeExternalDataSource,
+}
+
+func entryTypeName(t Ydb_Scheme.Entry_Type) string {
+	if name, ok := entryTypeNames[t]; ok {
+		return name
+	}
+	return entryTypeNames[Ydb_Scheme.Entry_TYPE_UNSPECIFIED]
+}
+
+type DataSourceParams struct {
+	Token string
+	// DefaultConnectionString is used when connection_string is not set in the data source.
+	DefaultConnectionString string
+}
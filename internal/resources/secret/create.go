@@ -0,0 +1,53 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretResource, err := secretResourceSchemaToResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: secretResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize table client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	err = executeSchemeQueries(ctx, db, []string{prepareCreateSecretQuery(secretResource.Name, secretResource.Value)})
+	if err != nil {
+		// NOTE: query is not added into the error, it contains the value of the secret.
+		return diag.Errorf("failed to create secret %q: %s", secretResource.Name, err)
+	}
+
+	d.SetId(secretResource.getConnectionString() + "?path=" + secretResource.Name)
+
+	access, err := changeAccess(schemeQueryExecutor(ctx, db), secretResource.Name, nil, secretResource.Access, nil)
+	if err != nil {
+		// NOTE: only subjects which were granted access are kept in the state.
+		if setErr := d.Set("access", access); setErr != nil {
+			return diag.FromErr(setErr)
+		}
+		return diag.Errorf("failed to grant access to secret %q: %s", secretResource.Name, err)
+	}
+
+	return h.Read(ctx, d, meta)
+}
@@ -0,0 +1,39 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// Delete revokes access to the secret and drops it.
+func (h *handler) Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretResource, err := secretResourceSchemaToResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: secretResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Errorf("failed to initialize table client: %s", err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	_, err = changeAccess(schemeQueryExecutor(ctx, db), secretResource.Name, secretResource.Access, nil, secretResource.Access)
+	if err != nil {
+		return diag.Errorf("failed to revoke access to secret %q: %s", secretResource.Name, err)
+	}
+	err = executeSchemeQueries(ctx, db, []string{prepareDropSecretQuery(secretResource.Name)})
+	if err != nil {
+		return diag.Errorf("failed to drop secret %q: %s", secretResource.Name, err)
+	}
+	return nil
+}
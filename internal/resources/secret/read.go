@@ -0,0 +1,62 @@
+package secret
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// Read fills the state from the ID: secrets can not be described, so existence and the value
+// of the secret are not checked. SECRET_ACCESS objects are read back if access is declared
+// or the secret is imported.
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretResource, err := secretResourceSchemaToResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("name", secretResource.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("connection_string", secretResource.getConnectionString())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// NOTE: value is required, so it is empty only after import.
+	imported := secretResource.Value == ""
+	if len(secretResource.Access) == 0 && !imported {
+		return nil
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: secretResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize table client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	access, err := describeAccess(ctx, db, secretResource.Name)
+	if err != nil {
+		// NOTE: the metadata table is created with the first SECRET_ACCESS object of the database.
+		if !strings.Contains(err.Error(), "SCHEME_ERROR") {
+			return diag.Errorf("failed to read access to secret %q: %s", secretResource.Name, err)
+		}
+		access = nil
+	}
+	return diag.FromErr(d.Set("access", access))
+}
@@ -0,0 +1,169 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+type Resource struct {
+	Entity           *helpers.YDBEntity
+	DatabaseEndpoint string
+	Name             string
+	Value            string
+	// Access are subjects which are allowed to use the secret.
+	Access []string
+}
+
+func (r *Resource) getConnectionString() string {
+	if r.DatabaseEndpoint != "" {
+		return r.DatabaseEndpoint
+	}
+	return r.Entity.PrepareFullYDBEndpoint()
+}
+
+func secretResourceSchemaToResource(d *schema.ResourceData) (*Resource, error) {
+	var entity *helpers.YDBEntity
+	var err error
+	if d.Id() != "" {
+		entity, err = helpers.ParseYDBEntityID(d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse secret entity: %w", err)
+		}
+	}
+
+	r := &Resource{
+		Entity:           entity,
+		DatabaseEndpoint: d.Get("connection_string").(string),
+		Name:             d.Get("name").(string),
+		Value:            d.Get("value").(string),
+		Access:           expandAccess(d.Get("access")),
+	}
+	if entity != nil {
+		r.Name = entity.GetEntityPath()
+	}
+	return r, nil
+}
+
+func expandAccess(v interface{}) []string {
+	set, ok := v.(*schema.Set)
+	if !ok {
+		return nil
+	}
+	subjects := make([]string, 0, set.Len())
+	for _, s := range set.List() {
+		subjects = append(subjects, s.(string))
+	}
+	sort.Strings(subjects)
+	return subjects
+}
+
+// diffAccess returns subjects which are granted and revoked access to the secret.
+func diffAccess(oldAccess, newAccess []string) (toGrant, toRevoke []string) {
+	oldSet := make(map[string]struct{}, len(oldAccess))
+	for _, v := range oldAccess {
+		oldSet[v] = struct{}{}
+	}
+	newSet := make(map[string]struct{}, len(newAccess))
+	for _, v := range newAccess {
+		newSet[v] = struct{}{}
+		if _, ok := oldSet[v]; !ok {
+			toGrant = append(toGrant, v)
+		}
+	}
+	for _, v := range oldAccess {
+		if _, ok := newSet[v]; !ok {
+			toRevoke = append(toRevoke, v)
+		}
+	}
+	return toGrant, toRevoke
+}
+
+// changeAccess revokes and grants access to the secret one subject at a time. It returns subjects
+// which have access after the executed queries, so the state matches the server when a query fails.
+func changeAccess(execute func(query string) error, name string, access, toGrant, toRevoke []string) ([]string, error) {
+	current := make(map[string]struct{}, len(access)+len(toGrant))
+	for _, v := range access {
+		current[v] = struct{}{}
+	}
+	result := func() []string {
+		subjects := make([]string, 0, len(current))
+		for v := range current {
+			subjects = append(subjects, v)
+		}
+		sort.Strings(subjects)
+		return subjects
+	}
+
+	for _, v := range toRevoke {
+		if err := execute(prepareRevokeAccessQuery(name, v)); err != nil {
+			return result(), fmt.Errorf("failed to revoke access of %q: %w", v, err)
+		}
+		delete(current, v)
+	}
+	for _, v := range toGrant {
+		if err := execute(prepareGrantAccessQuery(name, v)); err != nil {
+			return result(), fmt.Errorf("failed to grant access to %q: %w", v, err)
+		}
+		current[v] = struct{}{}
+	}
+	return result(), nil
+}
+
+// schemeQueryExecutor returns function executing scheme queries for changeAccess.
+func schemeQueryExecutor(ctx context.Context, db ydb.Connection) func(query string) error {
+	return func(query string) error {
+		return executeSchemeQueries(ctx, db, []string{query})
+	}
+}
+
+// describeAccess returns subjects which have SECRET_ACCESS objects of the secret.
+func describeAccess(ctx context.Context, db ydb.Connection, name string) ([]string, error) {
+	var subjects []string
+	err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		params := table.NewQueryParameters(
+			table.ValueParam("$secret", types.TextValue(name)),
+		)
+
+		subjects = subjects[:0]
+		res, err := s.StreamExecuteScanQuery(ctx, selectAccessQuery, params)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var subject string
+				if err = res.ScanNamed(named.OptionalWithDefault("accessSID", &subject)); err != nil {
+					return err
+				}
+				subjects = append(subjects, subject)
+			}
+		}
+		return res.Err()
+	})
+	sort.Strings(subjects)
+	return subjects, err
+}
+
+func executeSchemeQueries(ctx context.Context, db ydb.Connection, queries []string) error {
+	for _, q := range queries {
+		err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			return s.ExecuteSchemeQuery(ctx, q)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretResource, err := secretResourceSchemaToResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: secretResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize table client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	if d.HasChange("value") {
+		err = executeSchemeQueries(ctx, db, []string{prepareAlterSecretQuery(secretResource.Name, secretResource.Value)})
+		if err != nil {
+			return diag.Errorf("failed to change value of secret %q: %s", secretResource.Name, err)
+		}
+	}
+	if d.HasChange("access") {
+		o, n := d.GetChange("access")
+		oldAccess := expandAccess(o)
+		toGrant, toRevoke := diffAccess(oldAccess, expandAccess(n))
+		access, err := changeAccess(schemeQueryExecutor(ctx, db), secretResource.Name, oldAccess, toGrant, toRevoke)
+		if err != nil {
+			// NOTE: only the executed changes of access are kept in the state.
+			if setErr := d.Set("access", access); setErr != nil {
+				return diag.FromErr(setErr)
+			}
+			return diag.Errorf("failed to change access to secret %q: %s", secretResource.Name, err)
+		}
+	}
+
+	return h.Read(ctx, d, meta)
+}
@@ -0,0 +1,67 @@
+package secret
+
+import (
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+func appendObjectName(buf []byte, name string) []byte {
+	buf = append(buf, '`')
+	buf = helpers.AppendWithEscape(buf, name)
+	buf = append(buf, '`')
+	return buf
+}
+
+func appendValue(buf []byte, value string) []byte {
+	buf = append(buf, "value=\""...)
+	buf = helpers.AppendStringWithEscape(buf, value)
+	buf = append(buf, '"')
+	return buf
+}
+
+func prepareCreateSecretQuery(name, value string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "CREATE OBJECT "...)
+	buf = appendObjectName(buf, name)
+	buf = append(buf, " (TYPE SECRET) WITH "...)
+	buf = appendValue(buf, value)
+	return string(buf)
+}
+
+func prepareAlterSecretQuery(name, value string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "ALTER OBJECT "...)
+	buf = appendObjectName(buf, name)
+	buf = append(buf, " (TYPE SECRET) SET "...)
+	buf = appendValue(buf, value)
+	return string(buf)
+}
+
+func prepareDropSecretQuery(name string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "DROP OBJECT "...)
+	buf = appendObjectName(buf, name)
+	buf = append(buf, " (TYPE SECRET)"...)
+	return string(buf)
+}
+
+// NOTE: access to the secret is an object named `<secret>:<subject>`.
+func prepareGrantAccessQuery(name, subject string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "CREATE OBJECT "...)
+	buf = appendObjectName(buf, name+":"+subject)
+	buf = append(buf, " (TYPE SECRET_ACCESS)"...)
+	return string(buf)
+}
+
+func prepareRevokeAccessQuery(name, subject string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "DROP OBJECT "...)
+	buf = appendObjectName(buf, name+":"+subject)
+	buf = append(buf, " (TYPE SECRET_ACCESS)"...)
+	return string(buf)
+}
+
+// NOTE: SECRET_ACCESS objects are stored in the metadata table of the database, secrets of
+// other owners with the same name are not distinguished.
+const selectAccessQuery = `DECLARE $secret AS Utf8;
+SELECT accessSID FROM ` + "`.metadata/secrets/access`" + ` WHERE secretId = $secret;`
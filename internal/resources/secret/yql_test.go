@@ -0,0 +1,107 @@
+package secret
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrepareSecretQueries(t *testing.T) {
+	testData := []struct {
+		testName string
+		query    string
+		expected string
+	}{
+		{
+			testName: "create secret",
+			query:    prepareCreateSecretQuery("s3_secret_key", "se\"cr\\et"),
+			expected: "CREATE OBJECT `s3_secret_key` (TYPE SECRET) WITH value=\"se\\\"cr\\\\et\"",
+		},
+		{
+			testName: "alter secret",
+			query:    prepareAlterSecretQuery("s3_secret_key", "rotated"),
+			expected: "ALTER OBJECT `s3_secret_key` (TYPE SECRET) SET value=\"rotated\"",
+		},
+		{
+			testName: "drop secret",
+			query:    prepareDropSecretQuery("s3_secret_key"),
+			expected: "DROP OBJECT `s3_secret_key` (TYPE SECRET)",
+		},
+		{
+			testName: "grant access",
+			query:    prepareGrantAccessQuery("s3_secret_key", "robot@builtin"),
+			expected: "CREATE OBJECT `s3_secret_key:robot@builtin` (TYPE SECRET_ACCESS)",
+		},
+		{
+			testName: "revoke access",
+			query:    prepareRevokeAccessQuery("s3_secret_key", "robot@builtin"),
+			expected: "DROP OBJECT `s3_secret_key:robot@builtin` (TYPE SECRET_ACCESS)",
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			assert.Equal(t, v.expected, v.query)
+		})
+	}
+}
+
+func TestChangeAccess(t *testing.T) {
+	toGrant, toRevoke := diffAccess([]string{"a", "b"}, []string{"b", "c", "d"})
+	assert.Equal(t, []string{"c", "d"}, toGrant)
+	assert.Equal(t, []string{"a"}, toRevoke)
+
+	testData := []struct {
+		testName        string
+		failedQuery     string
+		expectedAccess  []string
+		expectedQueries []string
+		expectError     bool
+	}{
+		{
+			testName:       "all queries are executed",
+			expectedAccess: []string{"b", "c", "d"},
+			expectedQueries: []string{
+				"DROP OBJECT `key:a` (TYPE SECRET_ACCESS)",
+				"CREATE OBJECT `key:c` (TYPE SECRET_ACCESS)",
+				"CREATE OBJECT `key:d` (TYPE SECRET_ACCESS)",
+			},
+		},
+		{
+			testName:       "revoke fails",
+			failedQuery:    "DROP OBJECT `key:a` (TYPE SECRET_ACCESS)",
+			expectedAccess: []string{"a", "b"},
+			expectError:    true,
+		},
+		{
+			testName:       "second grant fails",
+			failedQuery:    "CREATE OBJECT `key:d` (TYPE SECRET_ACCESS)",
+			expectedAccess: []string{"b", "c"},
+			expectedQueries: []string{
+				"DROP OBJECT `key:a` (TYPE SECRET_ACCESS)",
+				"CREATE OBJECT `key:c` (TYPE SECRET_ACCESS)",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			var queries []string
+			execute := func(query string) error {
+				if query == v.failedQuery {
+					return fmt.Errorf("query failed")
+				}
+				queries = append(queries, query)
+				return nil
+			}
+			access, err := changeAccess(execute, "key", []string{"a", "b"}, toGrant, toRevoke)
+			assert.Equal(t, v.expectError, err != nil)
+			assert.Equal(t, v.expectedAccess, access)
+			assert.Equal(t, v.expectedQueries, queries)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/changefeed"
+)
+
+type changefeedDiff struct {
+	ToDrop   []string
+	ToCreate []*changefeed.ChangeDataCaptureSettings
+	// ConsumersToAlter contains declared consumers of changefeeds which are not recreated.
+	ConsumersToAlter map[string][]interface{}
+}
+
+func expandChangefeeds(tablePath string, v interface{}) []*changefeed.ChangeDataCaptureSettings {
+	raw, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	result := make([]*changefeed.ChangeDataCaptureSettings, 0, len(raw))
+	for _, r := range raw {
+		result = append(result, changefeed.ExpandChangefeed(tablePath, r.(map[string]interface{})))
+	}
+	return result
+}
+
+func consumersList(mp map[string]interface{}) []interface{} {
+	if consumers, ok := mp["consumer"].(*schema.Set); ok {
+		return consumers.List()
+	}
+	return nil
+}
+
+// prepareChangefeedDiff diffs changefeeds declared in the resource by name.
+func prepareChangefeedDiff(tablePath string, o, n []interface{}) *changefeedDiff {
+	oldChangefeeds := make(map[string]map[string]interface{}, len(o))
+	for _, v := range o {
+		mp := v.(map[string]interface{})
+		oldChangefeeds[mp["name"].(string)] = mp
+	}
+	newChangefeeds := make(map[string]struct{}, len(n))
+
+	diff := &changefeedDiff{
+		ConsumersToAlter: make(map[string][]interface{}),
+	}
+	for _, v := range n {
+		mp := v.(map[string]interface{})
+		cdc := changefeed.ExpandChangefeed(tablePath, mp)
+		newChangefeeds[cdc.Name] = struct{}{}
+
+		old, ok := oldChangefeeds[cdc.Name]
+		switch {
+		case !ok:
+			diff.ToCreate = append(diff.ToCreate, cdc)
+		case !changefeed.ExpandChangefeed(tablePath, old).SameSettings(cdc):
+			diff.ToDrop = append(diff.ToDrop, cdc.Name)
+			diff.ToCreate = append(diff.ToCreate, cdc)
+		case !reflect.DeepEqual(consumersList(old), consumersList(mp)):
+			diff.ConsumersToAlter[cdc.Name] = consumersList(mp)
+		}
+	}
+	for k := range oldChangefeeds {
+		if _, ok := newChangefeeds[k]; !ok {
+			diff.ToDrop = append(diff.ToDrop, k)
+		}
+	}
+	sort.Strings(diff.ToDrop)
+
+	return diff
+}
+
+func createChangefeeds(ctx context.Context, db ydb.Connection, changefeeds []*changefeed.ChangeDataCaptureSettings) error {
+	for _, cdc := range changefeeds {
+		q := changefeed.PrepareCreateRequest(cdc)
+		err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			return s.ExecuteSchemeQuery(ctx, q)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create changefeed %q: %w", cdc.Name, err)
+		}
+		if len(cdc.Consumers) == 0 {
+			continue
+		}
+		err = db.Topic().Alter(ctx, cdc.TablePath+"/"+cdc.Name, topicoptions.AlterWithAddConsumers(cdc.Consumers...))
+		if err != nil {
+			return fmt.Errorf("failed to add consumers to changefeed %q: %w", cdc.Name, err)
+		}
+	}
+	return nil
+}
+
+func updateChangefeeds(ctx context.Context, db ydb.Connection, tablePath string, diff *changefeedDiff) error {
+	for _, name := range diff.ToDrop {
+		q := changefeed.PrepareDropRequest(tablePath, name)
+		err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			return s.ExecuteSchemeQuery(ctx, q)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to drop changefeed %q: %w", name, err)
+		}
+	}
+
+	err := createChangefeeds(ctx, db, diff.ToCreate)
+	if err != nil {
+		return err
+	}
+
+	for name, consumers := range diff.ConsumersToAlter {
+		topicPath := tablePath + "/" + name
+		desc, err := db.Topic().Describe(ctx, topicPath)
+		if err != nil {
+			return fmt.Errorf("failed to describe changefeed %q: %w", name, err)
+		}
+		opts := changefeed.MergeConsumerSettings(consumers, desc.Consumers)
+		if len(opts) == 0 {
+			continue
+		}
+		err = db.Topic().Alter(ctx, topicPath, opts...)
+		if err != nil {
+			return fmt.Errorf("failed to alter consumers of changefeed %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// declaredChangefeeds filters changefeeds declared in the resource.
+func declaredChangefeeds(
+	d *schema.ResourceData,
+	tablePath string,
+	changefeeds []options.ChangefeedDescription,
+) []options.ChangefeedDescription {
+	declared := make(map[string]struct{})
+	for _, v := range expandChangefeeds(tablePath, d.Get("changefeed")) {
+		declared[v.Name] = struct{}{}
+	}
+
+	result := make([]options.ChangefeedDescription, 0, len(declared))
+	for _, v := range changefeeds {
+		if _, ok := declared[v.Name]; ok {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// describeChangefeedConsumers describes consumers of the changefeeds.
+func describeChangefeedConsumers(
+	ctx context.Context,
+	db ydb.Connection,
+	tablePath string,
+	changefeeds []options.ChangefeedDescription,
+) (map[string][]topictypes.Consumer, error) {
+	result := make(map[string][]topictypes.Consumer)
+	for _, v := range changefeeds {
+		desc, err := db.Topic().Describe(ctx, tablePath+"/"+v.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe changefeed %q: %w", v.Name, err)
+		}
+		result[v.Name] = desc.Consumers
+	}
+	return result, nil
+}
+
+// flattenChangefeeds flattens only changefeeds declared in the resource, changefeeds
+// managed by separate ydb_table_changefeed resources are skipped.
+func flattenChangefeeds(
+	d *schema.ResourceData,
+	changefeeds []options.ChangefeedDescription,
+	consumers map[string][]topictypes.Consumer,
+) []interface{} {
+	descriptions := make(map[string]options.ChangefeedDescription, len(changefeeds))
+	for _, v := range changefeeds {
+		descriptions[v.Name] = v
+	}
+
+	declared, _ := d.Get("changefeed").([]interface{})
+	result := make([]interface{}, 0, len(declared))
+	for _, v := range declared {
+		mp := v.(map[string]interface{})
+		desc, ok := descriptions[mp["name"].(string)]
+		if !ok {
+			continue
+		}
+		result = append(result, changefeed.FlattenChangefeed(mp, desc, consumers[desc.Name]))
+	}
+	return result
+}
+
+// flattenAllChangefeeds flattens all changefeeds of the table.
+// NOTE: virtual_timestamps and retention_period are not returned by DescribeTable.
+func flattenAllChangefeeds(
+	changefeeds []options.ChangefeedDescription,
+	consumers map[string][]topictypes.Consumer,
+) []interface{} {
+	result := make([]interface{}, 0, len(changefeeds))
+	for _, v := range changefeeds {
+		result = append(result, changefeed.FlattenChangefeed(map[string]interface{}{}, v, consumers[v.Name]))
+	}
+	return result
+}
@@ -57,5 +57,16 @@ func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta inter
 	id := tableResource.DatabaseEndpoint + "?path=" + tableResource.Path
 	d.SetId(id)
 
+	err = createChangefeeds(ctx, db, tableResource.Changefeeds)
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  "failed to create table changefeeds",
+				Detail:   err.Error(),
+			},
+		}
+	}
+
 	return h.Read(ctx, d, meta)
 }
@@ -0,0 +1,464 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers/yqltypes"
+)
+
+// defaultFamilyName is the name of column family which exists in every table.
+const defaultFamilyName = "default"
+
+// ttlColumnTypes contains types of columns which can be used for TTL.
+// NOTE: numeric TTL columns require a unit which is not supported yet.
+var ttlColumnTypes = map[string]struct{}{
+	"Date":      {},
+	"Datetime":  {},
+	"Timestamp": {},
+}
+
+// CustomizeDiff validates table settings which depend on each other at plan time.
+func CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateTableConsistency(d); err != nil {
+		return err
+	}
+	if err := validateStoreSettings(d); err != nil {
+		return err
+	}
+	if err := validateTTLSettings(d); err != nil {
+		return err
+	}
+	if err := validatePartitioningChanges(d); err != nil {
+		return err
+	}
+	if err := validatePrimaryKeyChange(d); err != nil {
+		return err
+	}
+	return planRenamedTableID(d)
+}
+
+// uniformPartitionsChanged reports whether uniform_partitions change forces replacement of the table.
+// NOTE: uniform_partitions is not returned by the server, zero in state means it is unknown (e.g. after import).
+func uniformPartitionsChanged(oSettings, nSettings map[string]interface{}) bool {
+	oUniform, _ := oSettings["uniform_partitions"].(int)
+	nUniform, _ := nSettings["uniform_partitions"].(int)
+	return oUniform != 0 && nUniform != 0 && oUniform != nUniform
+}
+
+// primaryKeyChangeForcesNew reports whether primary key is changed without migrate_data_on_replace.
+func primaryKeyChangeForcesNew(d *schema.ResourceDiff) bool {
+	if d.Id() == "" || !d.HasChange("primary_key") {
+		return false
+	}
+	return !d.Get("migrate_data_on_replace").(bool) || d.Get("store").(string) == storeTypeColumn
+}
+
+// validatePrimaryKeyChange forces replacement of the table on primary key change, unless data
+// is migrated into the new table with migrate_data_on_replace.
+func validatePrimaryKeyChange(d *schema.ResourceDiff) error {
+	if !primaryKeyChangeForcesNew(d) {
+		return nil
+	}
+	return d.ForceNew("primary_key")
+}
+
+// planRenamedTableID plans the new ID of the table renamed in place, so ydb_table_index and
+// ydb_table_changefeed referencing the table by `table_id` follow it within the same apply.
+// NOTE: ID of the replaced table is unknown and is planned by the SDK.
+func planRenamedTableID(d *schema.ResourceDiff) error {
+	if d.Id() == "" || !d.HasChange("path") || !d.NewValueKnown("path") {
+		return nil
+	}
+	return d.SetNew("id", d.Get("connection_string").(string)+"?path="+d.Get("path").(string))
+}
+
+// replacementReasons returns attributes which changes force replacement of the table.
+func replacementReasons(d *schema.ResourceDiff) []string {
+	if d.Id() == "" {
+		return nil
+	}
+	var reasons []string
+	for _, v := range []string{"connection_string", "store", "partition_by_hash"} {
+		if d.HasChange(v) {
+			reasons = append(reasons, v)
+		}
+	}
+	if primaryKeyChangeForcesNew(d) {
+		reasons = append(reasons, "primary_key")
+	}
+	if d.HasChange("partitioning_settings") {
+		o, n := d.GetChange("partitioning_settings")
+		if uniformPartitionsChanged(partitioningSettingsMap(o), partitioningSettingsMap(n)) {
+			reasons = append(reasons, "partitioning_settings.0.uniform_partitions")
+		}
+	}
+	return reasons
+}
+
+// validateTableConsistency checks that columns referenced by other table attributes are declared.
+// Checks are skipped for attributes which are not known at plan time.
+func validateTableConsistency(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("column") {
+		return nil
+	}
+
+	if err := validateDuplicateColumns(d); err != nil {
+		return err
+	}
+
+	columnTypes := make(map[string]string)
+	columnFamilies := make(map[string]string)
+	for _, l := range d.Get("column").(*schema.Set).List() {
+		m := l.(map[string]interface{})
+		name, _ := m["name"].(string)
+		if name == "" {
+			continue
+		}
+		typ, _ := m["type"].(string)
+		if normalized, err := yqltypes.NormalizeColumnType(typ); err == nil {
+			typ = normalized
+		}
+		columnTypes[name] = typ
+		columnFamilies[name], _ = m["family"].(string)
+	}
+
+	if d.NewValueKnown("primary_key") {
+		for i, v := range d.Get("primary_key").([]interface{}) {
+			name, _ := v.(string)
+			if _, ok := columnTypes[name]; name != "" && !ok {
+				return fmt.Errorf("primary_key.%d: column %q is not declared", i, name)
+			}
+		}
+	}
+
+	if d.NewValueKnown("family") {
+		families := map[string]struct{}{
+			defaultFamilyName: {},
+		}
+		for _, l := range d.Get("family").([]interface{}) {
+			m := l.(map[string]interface{})
+			name, _ := m["name"].(string)
+			families[name] = struct{}{}
+		}
+		for name, family := range columnFamilies {
+			if _, ok := families[family]; family != "" && !ok {
+				return fmt.Errorf("column: family %q of column %q is not declared in family blocks", family, name)
+			}
+		}
+	}
+
+	// NOTE: TTL is read from configuration, so TTL with tiers is checked too.
+	if ttl, ok := configuredTTL(d); ok && ttl != nil && ttl.ColumnName != "" {
+		typ, ok := columnTypes[ttl.ColumnName]
+		if !ok {
+			return fmt.Errorf("ttl.0.column_name: column %q is not declared", ttl.ColumnName)
+		}
+		if _, ok := ttlColumnTypes[typ]; !ok {
+			return fmt.Errorf("ttl.0.column_name: column %q has type %s, TTL column must be Date, Datetime or Timestamp", ttl.ColumnName, typ)
+		}
+	}
+
+	if partitionAtKeys, ok := configuredPartitionAtKeys(d); ok && d.NewValueKnown("primary_key") {
+		pkLen := len(d.Get("primary_key").([]interface{}))
+		for i, l := range partitionAtKeys {
+			m, _ := l.(map[string]interface{})
+			keys, _ := m["keys"].([]interface{})
+			if len(keys) > pkLen {
+				return fmt.Errorf(
+					"partitioning_settings.0.partition_at_keys.%d.keys: got %d keys, can not be more partition keys than primary key columns (%d)",
+					i, len(keys), pkLen,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// validateDuplicateColumns checks column names in configuration: columns are stored in a set
+// with normalized types, so columns which differ only in type aliases are merged in it.
+func validateDuplicateColumns(d *schema.ResourceDiff) error {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+	columns := raw.GetAttr("column")
+	if columns.IsNull() || !columns.IsKnown() {
+		return nil
+	}
+	names := make(map[string]struct{})
+	for it := columns.ElementIterator(); it.Next(); {
+		_, c := it.Element()
+		name := c.GetAttr("name")
+		if name.IsNull() || !name.IsKnown() {
+			continue
+		}
+		if _, ok := names[name.AsString()]; ok {
+			return fmt.Errorf("column: duplicate column name %q", name.AsString())
+		}
+		names[name.AsString()] = struct{}{}
+	}
+	return nil
+}
+
+// validatePartitioningChanges handles partitioning settings which are applied only on table
+// creation: changed uniform_partitions forces replacement and partition_at_keys of existing
+// table which are not among its split points are rejected. State contains the current split
+// points of the table, auto partitioning adds them, so the configured keys are kept as they are
+// in state when they are found there.
+func validatePartitioningChanges(d *schema.ResourceDiff) error {
+	if d.Id() == "" || d.Get("store").(string) == storeTypeColumn || !d.HasChange("partitioning_settings") {
+		return nil
+	}
+	o, n := d.GetChange("partitioning_settings")
+	oSettings := partitioningSettingsMap(o)
+	nSettings := partitioningSettingsMap(n)
+
+	if uniformPartitionsChanged(oSettings, nSettings) {
+		return d.ForceNew("partitioning_settings")
+	}
+	if !d.NewValueKnown("partitioning_settings") || len(nSettings) == 0 {
+		return nil
+	}
+
+	oKeys, _ := oSettings["partition_at_keys"].([]interface{})
+	if oKeys == nil {
+		oKeys = []interface{}{}
+	}
+	// NOTE: the new table of replacement or migration is created with the configured keys.
+	replaced := d.HasChange("primary_key") || len(replacementReasons(d)) > 0
+	configured, ok := configuredPartitionAtKeys(d)
+	if ok && !replaced {
+		columnTypes := partitionKeyColumnTypes(d)
+		for i, key := range configured {
+			if !containsPartitionKey(oKeys, key, columnTypes) {
+				return fmt.Errorf(
+					"partitioning_settings.0.partition_at_keys.%d: split points of existing table can not be changed, "+
+						"the table is split at %s; set partition_at_keys to them or remove it from the configuration",
+					i, formatPartitionAtKeys(oKeys),
+				)
+			}
+		}
+	}
+
+	settings := make(map[string]interface{}, len(nSettings))
+	for k, v := range nSettings {
+		settings[k] = v
+	}
+	settings["partition_at_keys"] = oKeys
+	return d.SetNew("partitioning_settings", []interface{}{settings})
+}
+
+// partitionKeyColumnTypes returns normalized types of primary key columns from the plan.
+func partitionKeyColumnTypes(d *schema.ResourceDiff) []string {
+	columnTypes := make(map[string]string)
+	for _, l := range d.Get("column").(*schema.Set).List() {
+		m := l.(map[string]interface{})
+		name, _ := m["name"].(string)
+		typ, _ := m["type"].(string)
+		columnTypes[name] = unwrapOptionalType(typ)
+	}
+	primaryKey := d.Get("primary_key").([]interface{})
+	result := make([]string, 0, len(primaryKey))
+	for _, v := range primaryKey {
+		name, _ := v.(string)
+		result = append(result, columnTypes[name])
+	}
+	return result
+}
+
+// containsPartitionKey reports whether partitionAtKeys contain the key, keys written differently
+// are compared as values of primary key columns.
+func containsPartitionKey(partitionAtKeys []interface{}, key interface{}, columnTypes []string) bool {
+	keys, _ := key.(map[string]interface{})["keys"].([]interface{})
+	for _, l := range partitionAtKeys {
+		if samePartitionKey(l.(map[string]interface{})["keys"].([]interface{}), keys, columnTypes) {
+			return true
+		}
+	}
+	return false
+}
+
+func samePartitionKey(a, b []interface{}, columnTypes []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		typ := ""
+		if i < len(columnTypes) {
+			typ = columnTypes[i]
+		}
+		if !sameLiteral(a[i].(string), b[i].(string), typ) {
+			return false
+		}
+	}
+	return true
+}
+
+func formatPartitionAtKeys(partitionAtKeys []interface{}) string {
+	result := make([]string, 0, len(partitionAtKeys))
+	for _, l := range partitionAtKeys {
+		keys, _ := l.(map[string]interface{})["keys"].([]interface{})
+		quoted := make([]string, 0, len(keys))
+		for _, k := range keys {
+			quoted = append(quoted, strconv.Quote(k.(string)))
+		}
+		result = append(result, "["+strings.Join(quoted, ", ")+"]")
+	}
+	return "[" + strings.Join(result, ", ") + "]"
+}
+
+func validateStoreSettings(d *schema.ResourceDiff) error {
+	if d.Get("store").(string) != storeTypeColumn {
+		if len(d.Get("partition_by_hash").([]interface{})) > 0 {
+			return fmt.Errorf("partition_by_hash can be set only for column tables")
+		}
+		return nil
+	}
+
+	var rowOnly []string
+	if len(d.Get("family").([]interface{})) > 0 {
+		rowOnly = append(rowOnly, "family")
+	}
+	if v, ok := d.GetOk("key_bloom_filter"); ok && v.(bool) {
+		rowOnly = append(rowOnly, "key_bloom_filter")
+	}
+	if len(d.Get("read_replicas").([]interface{})) > 0 {
+		rowOnly = append(rowOnly, "read_replicas")
+	}
+	if d.Get("index").(*schema.Set).Len() > 0 {
+		rowOnly = append(rowOnly, "index")
+	}
+	if len(d.Get("changefeed").([]interface{})) > 0 {
+		rowOnly = append(rowOnly, "changefeed")
+	}
+	for _, l := range d.Get("partitioning_settings").(*schema.Set).List() {
+		m := l.(map[string]interface{})
+		if v, ok := m["uniform_partitions"].(int); ok && v != 0 {
+			rowOnly = append(rowOnly, "partitioning_settings.uniform_partitions")
+		}
+
+		if v, ok := m["auto_partitioning_max_partitions_count"].(int); ok && v != 0 {
+			rowOnly = append(rowOnly, "partitioning_settings.auto_partitioning_max_partitions_count")
+		}
+		if v, ok := m["auto_partitioning_partition_size_mb"].(int); ok && v != 0 {
+			rowOnly = append(rowOnly, "partitioning_settings.auto_partitioning_partition_size_mb")
+		}
+		if v, ok := m["auto_partitioning_by_load"].(bool); ok && v {
+			rowOnly = append(rowOnly, "partitioning_settings.auto_partitioning_by_load")
+		}
+	}
+	if v, _ := configuredPartitionAtKeys(d); len(v) > 0 {
+		rowOnly = append(rowOnly, "partitioning_settings.partition_at_keys")
+	}
+	if len(rowOnly) > 0 {
+		return fmt.Errorf("%s can not be set for column tables", strings.Join(rowOnly, ", "))
+	}
+	if len(d.Get("partition_by_hash").([]interface{})) == 0 {
+		return fmt.Errorf("partition_by_hash is required for column tables")
+	}
+	return validateColumnTablePartitioningChange(d)
+}
+
+// validateColumnTablePartitioningChange rejects changed partitioning settings of existing column
+// table, they can not be altered. The check is skipped if the table is replaced anyway.
+func validateColumnTablePartitioningChange(d *schema.ResourceDiff) error {
+	if d.Id() == "" || !d.HasChange("partitioning_settings") || len(replacementReasons(d)) > 0 {
+		return nil
+	}
+	return fmt.Errorf("partitioning_settings: partitioning settings of column table can not be changed")
+}
+
+// validateTTLSettings checks TTL tiers at plan time, they are also checked on apply.
+func validateTTLSettings(d *schema.ResourceDiff) error {
+	ttl, ok := configuredTTL(d)
+	if !ok || ttl == nil {
+		return nil
+	}
+	return ttl.validate()
+}
+
+// configuredTTL reads TTL settings from configuration, since lists nested into set elements
+// are not read correctly from ResourceDiff. ok is false if configuration is not available or
+// TTL settings are not known yet.
+func configuredTTL(d *schema.ResourceDiff) (ttl *TTL, ok bool) {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil, false
+	}
+	settings := raw.GetAttr("ttl")
+	if settings.IsNull() {
+		return nil, true
+	}
+	if !settings.IsWhollyKnown() {
+		return nil, false
+	}
+	stringAttr := func(v cty.Value, name string) string {
+		if attr := v.GetAttr(name); !attr.IsNull() {
+			return attr.AsString()
+		}
+		return ""
+	}
+	for it := settings.ElementIterator(); it.Next(); {
+		_, s := it.Element()
+		ttl = &TTL{
+			ColumnName:     stringAttr(s, "column_name"),
+			ExpireInterval: stringAttr(s, "expire_interval"),
+		}
+		if tiers := s.GetAttr("tier"); !tiers.IsNull() {
+			for tit := tiers.ElementIterator(); tit.Next(); {
+				_, t := tit.Element()
+				ttl.Tiers = append(ttl.Tiers, &TTLTier{
+					ExpireInterval:     stringAttr(t, "expire_interval"),
+					ExternalDataSource: stringAttr(t, "external_data_source"),
+				})
+			}
+		}
+	}
+	return ttl, true
+}
+
+// configuredPartitionAtKeys reads partition_at_keys from configuration, since lists nested
+// into set elements are not read correctly from ResourceDiff. ok is false if configuration
+// is not available or partitioning settings are not known yet.
+func configuredPartitionAtKeys(d *schema.ResourceDiff) (result []interface{}, ok bool) {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil, false
+	}
+	settings := raw.GetAttr("partitioning_settings")
+	if settings.IsNull() {
+		return nil, true
+	}
+	if !settings.IsWhollyKnown() {
+		return nil, false
+	}
+	for it := settings.ElementIterator(); it.Next(); {
+		_, s := it.Element()
+		partitionAtKeys := s.GetAttr("partition_at_keys")
+		if partitionAtKeys.IsNull() {
+			continue
+		}
+		for pit := partitionAtKeys.ElementIterator(); pit.Next(); {
+			_, p := pit.Element()
+			keys := make([]interface{}, 0)
+			if k := p.GetAttr("keys"); !k.IsNull() {
+				for kit := k.ElementIterator(); kit.Next(); {
+					_, v := kit.Element()
+					if !v.IsNull() {
+						keys = append(keys, v.AsString())
+					}
+				}
+			}
+			result = append(result, map[string]interface{}{
+				"keys": keys,
+			})
+		}
+	}
+	return result, true
+}
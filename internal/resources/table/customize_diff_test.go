@@ -0,0 +1,861 @@
+package table
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers/yqltypes"
+)
+
+func consistencyTestResource() *schema.Resource {
+	columnResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":   {Type: schema.TypeString, Required: true},
+			"type":   {Type: schema.TypeString, Required: true},
+			"family": {Type: schema.TypeString, Optional: true},
+		},
+	}
+	// NOTE: columns are hashed with normalized types, like in the resource schema.
+	columnHash := func(v interface{}) int {
+		m := v.(map[string]interface{})
+		col := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			col[k] = v
+		}
+		if normalized, err := yqltypes.NormalizeColumnType(col["type"].(string)); err == nil {
+			col["type"] = normalized
+		}
+		return schema.HashResource(columnResource)(col)
+	}
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"column": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     columnResource,
+				Set:      columnHash,
+			},
+			"primary_key": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"family": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {Type: schema.TypeString, Required: true},
+					},
+				},
+			},
+			"ttl": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column_name":     {Type: schema.TypeString, Required: true},
+						"expire_interval": {Type: schema.TypeString, Optional: true},
+						"tier": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expire_interval":      {Type: schema.TypeString, Required: true},
+									"external_data_source": {Type: schema.TypeString, Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			"partitioning_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition_at_keys": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"keys": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return validateTableConsistency(d)
+		},
+	}
+}
+
+func TestValidateTableConsistency(t *testing.T) {
+	columns := []interface{}{
+		map[string]interface{}{"name": "a", "type": "Uint64"},
+		map[string]interface{}{"name": "b", "type": "Optional<Timestamp>", "family": "f"},
+		map[string]interface{}{"name": "c", "type": "Utf8", "family": "default"},
+	}
+	testData := []struct {
+		testName    string
+		config      map[string]interface{}
+		expectedErr string
+	}{
+		{
+			testName: "valid table",
+			config: map[string]interface{}{
+				"column":      columns,
+				"primary_key": []interface{}{"a", "b"},
+				"family":      []interface{}{map[string]interface{}{"name": "f"}},
+				"ttl": []interface{}{
+					map[string]interface{}{"column_name": "b", "expire_interval": "P1D"},
+				},
+				"partitioning_settings": []interface{}{
+					map[string]interface{}{
+						"partition_at_keys": []interface{}{
+							map[string]interface{}{"keys": []interface{}{"1", "2024-01-01T00:00:00Z"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			testName: "duplicate column name",
+			config: map[string]interface{}{
+				"column": []interface{}{
+					map[string]interface{}{"name": "a", "type": "Uint64"},
+					map[string]interface{}{"name": "a", "type": "Utf8"},
+				},
+				"primary_key": []interface{}{"a"},
+			},
+			expectedErr: `column: duplicate column name "a"`,
+		},
+		{
+			testName: "duplicate column with type alias",
+			config: map[string]interface{}{
+				"column": []interface{}{
+					map[string]interface{}{"name": "a", "type": "Utf8"},
+					map[string]interface{}{"name": "a", "type": "Text"},
+				},
+				"primary_key": []interface{}{"a"},
+			},
+			expectedErr: `column: duplicate column name "a"`,
+		},
+		{
+			testName: "undeclared primary key column",
+			config: map[string]interface{}{
+				"column":      columns,
+				"primary_key": []interface{}{"a", "d"},
+				"family":      []interface{}{map[string]interface{}{"name": "f"}},
+			},
+			expectedErr: `primary_key.1: column "d" is not declared`,
+		},
+		{
+			testName: "undeclared family",
+			config: map[string]interface{}{
+				"column":      columns,
+				"primary_key": []interface{}{"a"},
+			},
+			expectedErr: `column: family "f" of column "b" is not declared in family blocks`,
+		},
+		{
+			testName: "undeclared ttl column",
+			config: map[string]interface{}{
+				"column":      columns,
+				"primary_key": []interface{}{"a"},
+				"family":      []interface{}{map[string]interface{}{"name": "f"}},
+				"ttl": []interface{}{
+					map[string]interface{}{"column_name": "d", "expire_interval": "P1D"},
+				},
+			},
+			expectedErr: `ttl.0.column_name: column "d" is not declared`,
+		},
+		{
+			testName: "ttl column with wrong type",
+			config: map[string]interface{}{
+				"column":      columns,
+				"primary_key": []interface{}{"a"},
+				"family":      []interface{}{map[string]interface{}{"name": "f"}},
+				"ttl": []interface{}{
+					map[string]interface{}{"column_name": "c", "expire_interval": "P1D"},
+				},
+			},
+			expectedErr: `ttl.0.column_name: column "c" has type Utf8, TTL column must be Date, Datetime or Timestamp`,
+		},
+		{
+			testName: "tiered ttl column with wrong type",
+			config: map[string]interface{}{
+				"column":      columns,
+				"primary_key": []interface{}{"a"},
+				"family":      []interface{}{map[string]interface{}{"name": "f"}},
+				"ttl": []interface{}{
+					map[string]interface{}{
+						"column_name": "c",
+						"tier": []interface{}{
+							map[string]interface{}{"expire_interval": "P1D", "external_data_source": "/local/s3"},
+							map[string]interface{}{"expire_interval": "P30D"},
+						},
+					},
+				},
+			},
+			expectedErr: `ttl.0.column_name: column "c" has type Utf8, TTL column must be Date, Datetime or Timestamp`,
+		},
+		{
+			testName: "undeclared tiered ttl column",
+			config: map[string]interface{}{
+				"column":      columns,
+				"primary_key": []interface{}{"a"},
+				"family":      []interface{}{map[string]interface{}{"name": "f"}},
+				"ttl": []interface{}{
+					map[string]interface{}{
+						"column_name": "d",
+						"tier": []interface{}{
+							map[string]interface{}{"expire_interval": "P30D"},
+						},
+					},
+				},
+			},
+			expectedErr: `ttl.0.column_name: column "d" is not declared`,
+		},
+		{
+			testName: "too many partition keys",
+			config: map[string]interface{}{
+				"column":      columns,
+				"primary_key": []interface{}{"a"},
+				"family":      []interface{}{map[string]interface{}{"name": "f"}},
+				"partitioning_settings": []interface{}{
+					map[string]interface{}{
+						"partition_at_keys": []interface{}{
+							map[string]interface{}{"keys": []interface{}{"1"}},
+							map[string]interface{}{"keys": []interface{}{"2", "3"}},
+						},
+					},
+				},
+			},
+			expectedErr: "partitioning_settings.0.partition_at_keys.1.keys: got 2 keys, can not be more partition keys than primary key columns (1)",
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			r := consistencyTestResource()
+			raw, err := json.Marshal(v.config)
+			assert.NoError(t, err)
+			rawConfig, err := ctyjson.Unmarshal(raw, r.CoreConfigSchema().ImpliedType())
+			assert.NoError(t, err)
+			state := &terraform.InstanceState{
+				RawConfig: rawConfig,
+			}
+			_, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(v.config), nil)
+			if v.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, v.expectedErr)
+		})
+	}
+}
+
+func TestValidatePrimaryKeyChange(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"primary_key": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"migrate_data_on_replace": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"store": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return validatePrimaryKeyChange(d)
+		},
+	}
+	testData := []struct {
+		testName        string
+		config          map[string]interface{}
+		expectedReplace bool
+	}{
+		{
+			testName: "primary key is not changed",
+			config: map[string]interface{}{
+				"primary_key": []interface{}{"a"},
+			},
+		},
+		{
+			testName: "primary key is changed",
+			config: map[string]interface{}{
+				"primary_key": []interface{}{"a", "b"},
+			},
+			expectedReplace: true,
+		},
+		{
+			testName: "primary key is changed with migration",
+			config: map[string]interface{}{
+				"primary_key":             []interface{}{"b"},
+				"migrate_data_on_replace": true,
+			},
+		},
+		{
+			testName: "primary key of column table is changed with migration",
+			config: map[string]interface{}{
+				"primary_key":             []interface{}{"b"},
+				"migrate_data_on_replace": true,
+				"store":                   "column",
+			},
+			expectedReplace: true,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "grpc://localhost:2136/?database=/local?path=table",
+				Attributes: map[string]string{
+					"primary_key.#": "1",
+					"primary_key.0": "a",
+				},
+			}
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(v.config), nil)
+			assert.NoError(t, err)
+			assert.Equal(t, v.expectedReplace, diff != nil && diff.RequiresNew())
+		})
+	}
+}
+
+func TestPlanRenamedTableID(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"connection_string": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return planRenamedTableID(d)
+		},
+	}
+	testData := []struct {
+		testName        string
+		config          map[string]interface{}
+		expectedID      string
+		expectedReplace bool
+	}{
+		{
+			testName: "path is not changed",
+			config: map[string]interface{}{
+				"path":              "table",
+				"connection_string": "grpc://localhost:2136/?database=/local",
+			},
+		},
+		{
+			testName: "path is changed",
+			config: map[string]interface{}{
+				"path":              "dir/renamed",
+				"connection_string": "grpc://localhost:2136/?database=/local",
+			},
+			expectedID: "grpc://localhost:2136/?database=/local?path=dir/renamed",
+		},
+		{
+			testName: "table is replaced",
+			config: map[string]interface{}{
+				"path":              "dir/renamed",
+				"connection_string": "grpc://localhost:2137/?database=/local",
+			},
+			expectedReplace: true,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "grpc://localhost:2136/?database=/local?path=table",
+				Attributes: map[string]string{
+					"id":                "grpc://localhost:2136/?database=/local?path=table",
+					"path":              "table",
+					"connection_string": "grpc://localhost:2136/?database=/local",
+				},
+			}
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(v.config), nil)
+			assert.NoError(t, err)
+			assert.Equal(t, v.expectedReplace, diff != nil && diff.RequiresNew())
+			var id *terraform.ResourceAttrDiff
+			if diff != nil {
+				id = diff.Attributes["id"]
+			}
+			switch {
+			case v.expectedReplace:
+				assert.True(t, id != nil && id.NewComputed)
+			case v.expectedID == "":
+				assert.Nil(t, id)
+			default:
+				if assert.NotNil(t, id) {
+					assert.Equal(t, v.expectedID, id.New)
+					assert.False(t, id.NewComputed)
+				}
+			}
+		})
+	}
+}
+
+func TestReplacementReasons(t *testing.T) {
+	testData := []struct {
+		testName string
+		config   map[string]interface{}
+		expected []string
+	}{
+		{
+			testName: "in place change",
+			config: map[string]interface{}{
+				"connection_string": "grpc://localhost:2136/?database=/local",
+				"primary_key":       []interface{}{"a"},
+				"store":             "row",
+			},
+		},
+		{
+			testName: "connection string and primary key are changed",
+			config: map[string]interface{}{
+				"connection_string": "grpc://localhost:2137/?database=/local",
+				"primary_key":       []interface{}{"b"},
+				"store":             "row",
+			},
+			expected: []string{"connection_string", "primary_key"},
+		},
+		{
+			testName: "primary key is changed with migration",
+			config: map[string]interface{}{
+				"connection_string":       "grpc://localhost:2136/?database=/local",
+				"primary_key":             []interface{}{"b"},
+				"store":                   "row",
+				"migrate_data_on_replace": true,
+			},
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			// NOTE: on replacement CustomizeDiff is called again with empty state, so the first call is checked.
+			var calls [][]string
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"connection_string": {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: true,
+					},
+					"primary_key": {
+						Type:     schema.TypeList,
+						Required: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"store": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"partition_by_hash": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"migrate_data_on_replace": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+					"partitioning_settings": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"uniform_partitions": {Type: schema.TypeInt, Optional: true},
+							},
+						},
+					},
+				},
+				CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+					calls = append(calls, replacementReasons(d))
+					return nil
+				},
+			}
+			state := &terraform.InstanceState{
+				ID: "grpc://localhost:2136/?database=/local?path=table",
+				Attributes: map[string]string{
+					"connection_string": "grpc://localhost:2136/?database=/local",
+					"primary_key.#":     "1",
+					"primary_key.0":     "a",
+					"store":             "row",
+				},
+			}
+			_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(v.config), nil)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, calls)
+			assert.Equal(t, v.expected, calls[0])
+		})
+	}
+}
+
+func TestValidateColumnTablePartitioningChange(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"connection_string": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"store":             {Type: schema.TypeString, Optional: true},
+			"primary_key":       {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"partition_by_hash": {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"partitioning_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uniform_partitions":                     {Type: schema.TypeInt, Optional: true},
+						"auto_partitioning_min_partitions_count": {Type: schema.TypeInt, Optional: true},
+					},
+				},
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return validateColumnTablePartitioningChange(d)
+		},
+	}
+	testData := []struct {
+		testName      string
+		connection    string
+		minPartitions int
+		expectedErr   string
+	}{
+		{
+			testName:      "partitioning settings are not changed",
+			connection:    "grpc://localhost:2136/?database=/local",
+			minPartitions: 4,
+		},
+		{
+			testName:      "partitioning settings are changed",
+			connection:    "grpc://localhost:2136/?database=/local",
+			minPartitions: 8,
+			expectedErr:   "partitioning_settings: partitioning settings of column table can not be changed",
+		},
+		{
+			testName:      "partitioning settings are changed with replacement",
+			connection:    "grpc://localhost:2137/?database=/local",
+			minPartitions: 8,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			settings := func(minPartitions int) []interface{} {
+				return []interface{}{
+					map[string]interface{}{"auto_partitioning_min_partitions_count": minPartitions},
+				}
+			}
+			stateData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"connection_string":     "grpc://localhost:2136/?database=/local",
+				"store":                 "column",
+				"primary_key":           []interface{}{"id"},
+				"partition_by_hash":     []interface{}{"id"},
+				"partitioning_settings": settings(4),
+			})
+			stateData.SetId("grpc://localhost:2136/?database=/local?path=table")
+
+			_, err := r.Diff(context.Background(), stateData.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+				"connection_string":     v.connection,
+				"store":                 "column",
+				"primary_key":           []interface{}{"id"},
+				"partition_by_hash":     []interface{}{"id"},
+				"partitioning_settings": settings(v.minPartitions),
+			}), nil)
+			if v.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, v.expectedErr)
+		})
+	}
+}
+
+func TestValidatePartitioningChanges(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"store": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "row",
+			},
+			"column": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {Type: schema.TypeString, Required: true},
+						"type": {Type: schema.TypeString, Required: true},
+					},
+				},
+			},
+			"primary_key": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"partitioning_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uniform_partitions": {Type: schema.TypeInt, Optional: true, Computed: true},
+						"partition_at_keys": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"keys": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"auto_partitioning_min_partitions_count": {Type: schema.TypeInt, Optional: true, Computed: true},
+					},
+				},
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return validatePartitioningChanges(d)
+		},
+	}
+	partitionAtKeys := func(keys ...string) []interface{} {
+		result := make([]interface{}, 0, len(keys))
+		for _, k := range keys {
+			result = append(result, map[string]interface{}{"keys": []interface{}{k}})
+		}
+		return result
+	}
+	settings := func(minPartitions int, keys ...string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"uniform_partitions":                     0,
+				"partition_at_keys":                      partitionAtKeys(keys...),
+				"auto_partitioning_min_partitions_count": minPartitions,
+			},
+		}
+	}
+
+	testData := []struct {
+		testName         string
+		state            []interface{}
+		config           []interface{}
+		expectedSettings []interface{}
+		expectedErr      string
+	}{
+		{
+			testName: "table is not changed",
+			state:    settings(2, "10"),
+			config:   settings(2, "10"),
+		},
+		{
+			testName: "table is split by auto partitioning",
+			state:    settings(2, "10", "15", "20"),
+			config:   settings(2, "10"),
+		},
+		{
+			testName:         "split table with changed auto partitioning",
+			state:            settings(2, "10", "15", "20"),
+			config:           settings(4, "10"),
+			expectedSettings: settings(4, "10", "15", "20"),
+		},
+		{
+			testName: "partition keys are changed",
+			state:    settings(2, "10"),
+			config:   settings(2, "10", "30"),
+			expectedErr: "partitioning_settings.0.partition_at_keys.1: split points of existing table can not be changed, " +
+				`the table is split at [["10"]]; set partition_at_keys to them or remove it from the configuration`,
+		},
+		{
+			testName:         "partition keys are not configured",
+			state:            settings(2, "10", "15", "20"),
+			config:           []interface{}{map[string]interface{}{"auto_partitioning_min_partitions_count": 4}},
+			expectedSettings: settings(4, "10", "15", "20"),
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			columns := []interface{}{map[string]interface{}{"name": "id", "type": "Uint64"}}
+			stateData := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"column":                columns,
+				"primary_key":           []interface{}{"id"},
+				"partitioning_settings": v.state,
+			})
+			stateData.SetId("grpc://localhost:2136/?database=/local?path=table")
+			state := stateData.State()
+
+			config := map[string]interface{}{
+				"column":                columns,
+				"primary_key":           []interface{}{"id"},
+				"partitioning_settings": v.config,
+			}
+			raw, err := json.Marshal(config)
+			assert.NoError(t, err)
+			state.RawConfig, err = ctyjson.Unmarshal(raw, r.CoreConfigSchema().ImpliedType())
+			assert.NoError(t, err)
+
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+			if v.expectedErr != "" {
+				assert.EqualError(t, err, v.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			if v.expectedSettings == nil {
+				assert.True(t, diff == nil || diff.Empty(), "unexpected diff: %v", diff)
+				return
+			}
+			assert.False(t, diff.RequiresNew())
+
+			newSettings := schema.NewSet(schema.HashResource(r.Schema["partitioning_settings"].Elem.(*schema.Resource)), v.expectedSettings)
+			element := newSettings.List()[0]
+			prefix := fmt.Sprintf("partitioning_settings.%d.", newSettings.F(element))
+			assert.Equal(t, "3", diff.Attributes[prefix+"partition_at_keys.#"].New)
+			assert.Equal(t, "4", diff.Attributes[prefix+"auto_partitioning_min_partitions_count"].New)
+		})
+	}
+}
+
+func TestValidateTTLSettings(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ttl": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column_name":     {Type: schema.TypeString, Required: true},
+						"expire_interval": {Type: schema.TypeString, Optional: true},
+						"tier": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expire_interval":      {Type: schema.TypeString, Required: true},
+									"external_data_source": {Type: schema.TypeString, Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return validateTTLSettings(d)
+		},
+	}
+	testData := []struct {
+		testName    string
+		ttl         map[string]interface{}
+		expectedErr string
+	}{
+		{
+			testName: "expire interval",
+			ttl:      map[string]interface{}{"column_name": "ts", "expire_interval": "P1D"},
+		},
+		{
+			testName: "tiers",
+			ttl: map[string]interface{}{
+				"column_name": "ts",
+				"tier": []interface{}{
+					map[string]interface{}{"expire_interval": "P1D", "external_data_source": "/local/s3"},
+					map[string]interface{}{"expire_interval": "P30D"},
+				},
+			},
+		},
+		{
+			testName: "expire interval with tiers",
+			ttl: map[string]interface{}{
+				"column_name":     "ts",
+				"expire_interval": "P1D",
+				"tier": []interface{}{
+					map[string]interface{}{"expire_interval": "P30D"},
+				},
+			},
+			expectedErr: "ttl.0: `expire_interval` and `tier` can not be used together",
+		},
+		{
+			testName:    "neither expire interval nor tiers",
+			ttl:         map[string]interface{}{"column_name": "ts"},
+			expectedErr: "ttl.0: either `expire_interval` or `tier` must be set",
+		},
+		{
+			testName: "deleting tier is not the last one",
+			ttl: map[string]interface{}{
+				"column_name": "ts",
+				"tier": []interface{}{
+					map[string]interface{}{"expire_interval": "P1D"},
+					map[string]interface{}{"expire_interval": "P30D", "external_data_source": "/local/s3"},
+				},
+			},
+			expectedErr: "ttl.0.tier.0: only the last tier can delete rows",
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			config := map[string]interface{}{
+				"ttl": []interface{}{v.ttl},
+			}
+			raw, err := json.Marshal(config)
+			assert.NoError(t, err)
+			rawConfig, err := ctyjson.Unmarshal(raw, r.CoreConfigSchema().ImpliedType())
+			assert.NoError(t, err)
+			state := &terraform.InstanceState{
+				RawConfig: rawConfig,
+			}
+			_, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+			if v.expectedErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, v.expectedErr)
+		})
+	}
+}
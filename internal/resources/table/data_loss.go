@@ -0,0 +1,52 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+// CheckReplacementDataLoss warns if replacement of the table planned in the diff destroys a
+// non-empty table, with failOnDataLoss such replacement is rejected. Table statistics are taken
+// from the state, they are refreshed by Read before the plan, so no requests are made to the server.
+// NOTE: plugin SDK can not attach warnings to the plan, so the warning is written into the provider log.
+func CheckReplacementDataLoss(d *schema.ResourceDiff, failOnDataLoss bool) error {
+	msg, err := replacementDataLoss(d)
+	if err != nil || msg == "" {
+		return err
+	}
+	if failOnDataLoss {
+		return errors.New(msg)
+	}
+	log.Printf("[WARN] %s", msg)
+	return nil
+}
+
+// replacementDataLoss returns description of data lost by the planned replacement of the table,
+// it is empty if the table is not replaced or is empty.
+func replacementDataLoss(d *schema.ResourceDiff) (string, error) {
+	reasons := replacementReasons(d)
+	if len(reasons) == 0 {
+		return "", nil
+	}
+
+	rows, _ := d.GetChange("rows_estimate")
+	size, _ := d.GetChange("store_size_bytes")
+	if rowsEstimate, _ := rows.(int); rowsEstimate == 0 {
+		return "", nil
+	}
+
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return "", fmt.Errorf("failed to parse table entity: %w", err)
+	}
+	return fmt.Sprintf(
+		"table %q will be replaced because of changed %s, its data will be lost: about %d rows, %d bytes",
+		entity.GetEntityPath(), strings.Join(reasons, ", "), rows, size,
+	), nil
+}
@@ -0,0 +1,103 @@
+package table
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckReplacementDataLoss(t *testing.T) {
+	testData := []struct {
+		testName        string
+		rowsEstimate    string
+		connection      string
+		failOnDataLoss  bool
+		expectedErr     string
+		expectedWarning string
+	}{
+		{
+			testName:       "table is not replaced",
+			rowsEstimate:   "42",
+			connection:     "grpc://localhost:2136/?database=/local",
+			failOnDataLoss: true,
+		},
+		{
+			testName:       "empty table is replaced",
+			rowsEstimate:   "0",
+			connection:     "grpc://localhost:2137/?database=/local",
+			failOnDataLoss: true,
+		},
+		{
+			testName:       "non-empty table is replaced",
+			rowsEstimate:   "42",
+			connection:     "grpc://localhost:2137/?database=/local",
+			failOnDataLoss: true,
+			expectedErr: `table "table" will be replaced because of changed connection_string, its data will be lost: ` +
+				"about 42 rows, 1024 bytes",
+		},
+		{
+			testName:     "non-empty table is replaced with disabled check",
+			rowsEstimate: "42",
+			connection:   "grpc://localhost:2137/?database=/local",
+			expectedWarning: `[WARN] table "table" will be replaced because of changed connection_string, its data will be lost: ` +
+				"about 42 rows, 1024 bytes\n",
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"connection_string": {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: true,
+					},
+					"rows_estimate": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"store_size_bytes": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+				CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+					return CheckReplacementDataLoss(d, v.failOnDataLoss)
+				},
+			}
+			state := &terraform.InstanceState{
+				ID: "grpc://localhost:2136/?database=/local?path=table",
+				Attributes: map[string]string{
+					"connection_string": "grpc://localhost:2136/?database=/local",
+					"rows_estimate":     v.rowsEstimate,
+					"store_size_bytes":  "1024",
+				},
+			}
+			var logs bytes.Buffer
+			log.SetOutput(&logs)
+			log.SetFlags(0)
+			defer func() {
+				log.SetOutput(os.Stderr)
+				log.SetFlags(log.LstdFlags)
+			}()
+
+			_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+				"connection_string": v.connection,
+			}), nil)
+			if v.expectedErr == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, v.expectedWarning, logs.String())
+				return
+			}
+			assert.EqualError(t, err, v.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+type DataSourceParams struct {
+	Token string
+	// DefaultConnectionString is used when connection_string is not set in the data source.
+	DefaultConnectionString string
+}
+
+// ReadDataSource looks up the table by path. Unlike the resource, all indexes and changefeeds
+// of the table are returned, not only the declared ones.
+func ReadDataSource(ctx context.Context, d *schema.ResourceData, params DataSourceParams) diag.Diagnostics {
+	entity, err := dataSourceEntity(d, params.DefaultConnectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		Token:            params.Token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize table client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	description, err := DescribeTable(ctx, db, entity.GetFullEntityPath())
+	if err != nil {
+		if strings.Contains(err.Error(), "SCHEME_ERROR") {
+			return diag.Errorf("table %q does not exist", entity.GetEntityPath())
+		}
+		return diag.Errorf("failed to describe table %q: %s", entity.GetEntityPath(), err)
+	}
+
+	storeType, err := DescribeStoreType(ctx, db, entity.GetFullEntityPath())
+	if err != nil {
+		return diag.Errorf("failed to describe path of table %q: %s", entity.GetEntityPath(), err)
+	}
+
+	err = FlattenComputedTableDescription(ctx, db, d, description, entity, storeType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(entity.ID())
+	return nil
+}
+
+func dataSourceEntity(d *schema.ResourceData, defaultConnectionString string) (*helpers.YDBEntity, error) {
+	connectionString, err := helpers.DataSourceConnectionString(d, defaultConnectionString)
+	if err != nil {
+		return nil, err
+	}
+	tablePath := strings.Trim(d.Get("path").(string), "/")
+	entity, err := helpers.ParseYDBEntityID(connectionString + "?path=" + tablePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse table entity: %w", err)
+	}
+	return entity, nil
+}
+
+// DescribeTable describes the table with statistics and partitioning like ydb_table resource.
+func DescribeTable(ctx context.Context, db ydb.Connection, fullPath string) (description options.Description, err error) {
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		description, err = s.DescribeTable(
+			ctx,
+			fullPath,
+			options.WithPartitionStats(),
+			options.WithShardKeyBounds(),
+			options.WithTableStats(),
+		)
+		return err
+	})
+	return description, err
+}
+
+// FlattenComputedTableDescription flattens description of the table which is not managed by the
+// resource, e.g. by the data source or ydb_table_copy: all indexes and changefeeds are set.
+func FlattenComputedTableDescription(
+	ctx context.Context,
+	db ydb.Connection,
+	d *schema.ResourceData,
+	desc options.Description,
+	entity *helpers.YDBEntity,
+	storeType string,
+) error {
+	consumers, err := describeChangefeedConsumers(ctx, db, entity.GetEntityPath(), desc.Changefeeds)
+	if err != nil {
+		return err
+	}
+	err = FlattenTableDescription(d, desc, entity, storeType)
+	if err != nil {
+		return err
+	}
+	indexTypes, err := describeIndexTypes(ctx, db, entity.GetFullEntityPath())
+	if err != nil {
+		return fmt.Errorf("failed to describe indexes: %w", err)
+	}
+	err = d.Set("index", flattenAllIndexes(desc.Indexes, indexTypes))
+	if err != nil {
+		return err
+	}
+	return d.Set("changefeed", flattenAllChangefeeds(desc.Changefeeds, consumers))
+}
+
+// flattenAllIndexes flattens all indexes of the table, type of the index is taken from types
+// and is left empty if it is not known.
+func flattenAllIndexes(indexes []options.IndexDescription, types map[string]string) []interface{} {
+	result := make([]interface{}, 0, len(indexes))
+	for _, v := range indexes {
+		result = append(result, flattenIndex(v, types[v.Name]))
+	}
+	return result
+}
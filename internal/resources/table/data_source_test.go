@@ -0,0 +1,62 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceEntity(t *testing.T) {
+	dataSourceSchema := map[string]*schema.Schema{
+		"path":              {Type: schema.TypeString, Required: true},
+		"connection_string": {Type: schema.TypeString, Optional: true, Computed: true},
+	}
+
+	testData := []struct {
+		testName                string
+		raw                     map[string]interface{}
+		defaultConnectionString string
+		expectedID              string
+		expectError             bool
+	}{
+		{
+			testName: "connection string from data source",
+			raw: map[string]interface{}{
+				"path":              "dir/table",
+				"connection_string": "grpcs://ydb.example.com:2135/?database=/ru/db",
+			},
+			defaultConnectionString: "grpc://localhost:2136/?database=/local",
+			expectedID:              "grpcs://ydb.example.com:2135/?database=/ru/db?path=dir/table",
+		},
+		{
+			testName: "connection string from provider endpoint",
+			raw: map[string]interface{}{
+				"path": "/dir/table",
+			},
+			defaultConnectionString: "grpc://localhost:2136/?database=/local",
+			expectedID:              "grpc://localhost:2136/?database=/local?path=dir/table",
+		},
+		{
+			testName: "no connection string",
+			raw: map[string]interface{}{
+				"path": "dir/table",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceSchema, v.raw)
+			entity, err := dataSourceEntity(d, v.defaultConnectionString)
+			if v.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, v.expectedID, entity.ID())
+		})
+	}
+}
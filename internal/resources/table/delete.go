@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
 	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
 )
 
@@ -24,6 +25,10 @@ func (h *handler) Delete(ctx context.Context, d *schema.ResourceData, cfg interf
 		}
 	}
 
+	if diags := helpers.CheckDeletionProtection(d, "table", tableResource.Path); diags != nil {
+		return diags
+	}
+
 	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
 		DatabaseEndpoint: tableResource.getConnectionString(),
 		Token:            h.token,
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
 	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
 )
 
@@ -48,6 +49,9 @@ func (h *handler) Delete(ctx context.Context, d *schema.ResourceData, meta inter
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if diags := helpers.CheckDeletionProtection(d, "index", indexResource.Name); diags != nil {
+		return diags
+	}
 	return h.dropIndex(ctx, dropIndexParams{
 		name:             indexResource.Name,
 		databaseEndpoint: indexResource.getConnectionString(),
@@ -2,21 +2,135 @@ package index
 
 import (
 	"context"
+	"fmt"
+	"path"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
 )
 
+// tableSession is a part of table.Session used to update index.
+type tableSession interface {
+	DescribeTable(ctx context.Context, path string, opts ...options.DescribeTableOption) (options.Description, error)
+	ExecuteSchemeQuery(ctx context.Context, query string, opts ...options.ExecuteSchemeQueryOption) error
+}
+
 func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// NOTE(shmel1k@): currently all parameters are 'force new', so only read can be done here.
-	err := h.dropIndex(ctx, dropIndexParams{
-		name:             d.Get("name").(string),
-		databaseEndpoint: d.Get("connection_string").(string),
-		tablePath:        d.Get("table_path").(string),
+	if !d.HasChangeExcept("deletion_protection") {
+		return h.Read(ctx, d, meta)
+	}
+
+	indexResource, err := indexResourceSchemaToIndexResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: indexResource.getConnectionString(),
+		Token:            h.token,
 	})
 	if err != nil {
-		return err
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize table client",
+				Detail:   err.Error(),
+			},
+		}
 	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
 
-	return h.Create(ctx, d, meta)
+	// NOTE: table_path differs from the table in ID when the table was renamed.
+	oldTablePath := parseTablePathFromIndexEntity(indexResource.Entity.GetEntityPath())
+	protected := d.Get("deletion_protection").(bool)
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		return updateIndex(ctx, s, db.Name(), oldTablePath, indexResource, protected)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(indexResource.getConnectionString() + "?path=" + indexResource.getTablePath() + "/" + indexResource.Name)
+
+	return h.Read(ctx, d, meta)
+}
+
+// updateIndex brings the index on the table at r's path to the declared state. The index found
+// there is kept if its cover is not changed: it was moved together with the renamed table.
+// Otherwise the index is recreated, it is dropped from the table at oldTablePath if it is left there.
+func updateIndex(
+	ctx context.Context,
+	s tableSession,
+	database string,
+	oldTablePath string,
+	r *resource,
+	protected bool,
+) error {
+	tablePath := r.getTablePath()
+	current, found, err := describeIndex(ctx, s, path.Join(database, tablePath), r.Name)
+	if err != nil {
+		return fmt.Errorf("failed to describe table %q: %w", tablePath, err)
+	}
+	if found && equalColumns(current.DataColumns, r.Cover) {
+		return nil
+	}
+
+	dropFrom := tablePath
+	if !found && oldTablePath != tablePath {
+		dropFrom = oldTablePath
+		_, found, err = describeIndex(ctx, s, path.Join(database, oldTablePath), r.Name)
+		if err != nil && !strings.Contains(err.Error(), "SCHEME_ERROR") {
+			return fmt.Errorf("failed to describe table %q: %w", oldTablePath, err)
+		}
+	}
+	if found {
+		if protected {
+			return fmt.Errorf(
+				"index %q is protected from deletion, set deletion_protection to false and apply the change before recreating the index",
+				r.Name,
+			)
+		}
+		err = s.ExecuteSchemeQuery(ctx, prepareDropRequest(dropFrom, r.Name))
+		if err != nil {
+			return fmt.Errorf("failed to drop index %q from table %q: %w", r.Name, dropFrom, err)
+		}
+	}
+
+	err = s.ExecuteSchemeQuery(ctx, prepareCreateIndexRequest(r))
+	if err != nil {
+		return fmt.Errorf("failed to create index %q on table %q: %w", r.Name, tablePath, err)
+	}
+	return nil
+}
+
+func describeIndex(ctx context.Context, s tableSession, tablePath, name string) (options.IndexDescription, bool, error) {
+	description, err := s.DescribeTable(ctx, tablePath)
+	if err != nil {
+		return options.IndexDescription{}, false, err
+	}
+	for _, v := range description.Indexes {
+		if v.Name == name {
+			return v, true, nil
+		}
+	}
+	return options.IndexDescription{}, false, nil
+}
+
+func equalColumns(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
@@ -0,0 +1,131 @@
+package index
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+type fakeTableSession struct {
+	tables  map[string]options.Description
+	queries []string
+}
+
+func (f *fakeTableSession) DescribeTable(_ context.Context, tablePath string, _ ...options.DescribeTableOption) (options.Description, error) {
+	description, ok := f.tables[tablePath]
+	if !ok {
+		return options.Description{}, fmt.Errorf("SCHEME_ERROR: path %q not found", tablePath)
+	}
+	return description, nil
+}
+
+func (f *fakeTableSession) ExecuteSchemeQuery(_ context.Context, query string, _ ...options.ExecuteSchemeQueryOption) error {
+	f.queries = append(f.queries, query)
+	return nil
+}
+
+func TestUpdateIndex(t *testing.T) {
+	index := options.IndexDescription{
+		Name:         "index",
+		IndexColumns: []string{"a"},
+		DataColumns:  []string{"b"},
+	}
+	testData := []struct {
+		testName        string
+		tables          map[string]options.Description
+		oldTablePath    string
+		cover           []string
+		protected       bool
+		expectedQueries []string
+		expectError     bool
+	}{
+		{
+			testName: "index is moved with renamed table",
+			tables: map[string]options.Description{
+				"/local/renamed": {Indexes: []options.IndexDescription{index}},
+			},
+			oldTablePath: "table",
+			cover:        []string{"b"},
+			protected:    true,
+		},
+		{
+			testName: "index is moved from the previous table",
+			tables: map[string]options.Description{
+				"/local/table":   {Indexes: []options.IndexDescription{index}},
+				"/local/renamed": {},
+			},
+			oldTablePath: "table",
+			cover:        []string{"b"},
+			expectedQueries: []string{
+				"ALTER TABLE `table` DROP INDEX `index`",
+				"ALTER TABLE `renamed` ADD INDEX `index` GLOBAL SYNC ON (`a`) COVER (`b`)",
+			},
+		},
+		{
+			testName: "index is created when previous table is dropped",
+			tables: map[string]options.Description{
+				"/local/renamed": {},
+			},
+			oldTablePath: "table",
+			cover:        []string{"b"},
+			protected:    true,
+			expectedQueries: []string{
+				"ALTER TABLE `renamed` ADD INDEX `index` GLOBAL SYNC ON (`a`) COVER (`b`)",
+			},
+		},
+		{
+			testName: "cover is changed",
+			tables: map[string]options.Description{
+				"/local/renamed": {Indexes: []options.IndexDescription{index}},
+			},
+			oldTablePath: "renamed",
+			cover:        []string{"c"},
+			expectedQueries: []string{
+				"ALTER TABLE `renamed` DROP INDEX `index`",
+				"ALTER TABLE `renamed` ADD INDEX `index` GLOBAL SYNC ON (`a`) COVER (`c`)",
+			},
+		},
+		{
+			testName: "cover of protected index is changed",
+			tables: map[string]options.Description{
+				"/local/renamed": {Indexes: []options.IndexDescription{index}},
+			},
+			oldTablePath: "renamed",
+			cover:        []string{"c"},
+			protected:    true,
+			expectError:  true,
+		},
+		{
+			testName:     "table does not exist",
+			tables:       map[string]options.Description{},
+			oldTablePath: "table",
+			cover:        []string{"b"},
+			expectError:  true,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			s := &fakeTableSession{tables: v.tables}
+			r := &resource{
+				TablePath: "renamed",
+				Name:      "index",
+				Type:      "global_sync",
+				Columns:   []string{"a"},
+				Cover:     v.cover,
+			}
+			err := updateIndex(context.Background(), s, "/local", v.oldTablePath, r, v.protected)
+			if v.expectError {
+				assert.Error(t, err)
+				assert.Empty(t, s.queries)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, v.expectedQueries, s.queries)
+		})
+	}
+}
@@ -0,0 +1,342 @@
+package table
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers/yqltypes"
+)
+
+const (
+	layoutDate      = "2006-01-02"
+	layoutDatetime  = "2006-01-02T15:04:05Z"
+	layoutTimestamp = "2006-01-02T15:04:05.000000Z"
+)
+
+var (
+	uuidRegexp     = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	intervalRegexp = regexp.MustCompile(`^(-)?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d{1,6})?)S)?)?$`)
+	numberRegexp   = regexp.MustCompile(`^[-+]?(\d+)(?:\.(\d+))?$`)
+)
+
+// Literal is a typed value of a primary key column, e.g. a partition boundary.
+// Value is always kept in canonical form, so two literals can be compared by value.
+type Literal struct {
+	Type  string
+	Value string
+}
+
+func (l *Literal) ToYQL() string {
+	buf := make([]byte, 0, 32)
+	typ := unwrapOptionalType(l.Type)
+	switch {
+	case isIntColumn(typ) || isUintColumn(typ) || isBoolColumn(typ):
+		buf = append(buf, l.Value...)
+	case isStringColumn(typ):
+		buf = append(buf, '"')
+		buf = helpers.AppendWithEscape(buf, l.Value)
+		buf = append(buf, '"')
+	case isUTF8Column(typ):
+		buf = append(buf, '"')
+		buf = helpers.AppendWithEscape(buf, l.Value)
+		buf = append(buf, '"', 'u')
+	case isDecimalColumn(typ):
+		precision, scale, _ := parseDecimalType(typ)
+		buf = append(buf, "Decimal(\""...)
+		buf = append(buf, l.Value...)
+		buf = append(buf, '"', ',', ' ')
+		buf = strconv.AppendUint(buf, uint64(precision), 10)
+		buf = append(buf, ',', ' ')
+		buf = strconv.AppendUint(buf, uint64(scale), 10)
+		buf = append(buf, ')')
+	default:
+		buf = append(buf, typ...)
+		buf = append(buf, '(', '"')
+		buf = helpers.AppendWithEscape(buf, l.Value)
+		buf = append(buf, '"', ')')
+	}
+	return string(buf)
+}
+
+// unwrapOptionalType returns canonical column type without Optional wrapper.
+func unwrapOptionalType(typ string) string {
+	t, err := yqltypes.NormalizeColumnType(typ)
+	if err != nil {
+		return strings.TrimSpace(typ)
+	}
+	return t
+}
+
+func isDecimalColumn(typ string) bool {
+	return strings.HasPrefix(typ, "Decimal(")
+}
+
+func parseDecimalType(typ string) (precision, scale uint32, err error) {
+	t, err := yqltypes.Parse(typ)
+	if err != nil {
+		return 0, 0, err
+	}
+	if t.Name != "Decimal" {
+		return 0, 0, fmt.Errorf("invalid decimal type %q", typ)
+	}
+	return t.Precision, t.Scale, nil
+}
+
+func parseDecimalValue(k string, precision, scale uint32) (string, error) {
+	m := numberRegexp.FindStringSubmatch(k)
+	if m == nil {
+		return "", fmt.Errorf("invalid decimal value %q", k)
+	}
+	if uint32(len(strings.TrimRight(m[2], "0"))) > scale {
+		return "", fmt.Errorf("decimal value %q has more than %d fractional digits", k, scale)
+	}
+	r, ok := new(big.Rat).SetString(k)
+	if !ok {
+		return "", fmt.Errorf("invalid decimal value %q", k)
+	}
+	v := r.FloatString(int(scale))
+	if digits := len(strings.Replace(strings.TrimLeft(v, "-"), ".", "", 1)); uint32(digits) > precision {
+		return "", fmt.Errorf("decimal value %q does not fit into precision %d", k, precision)
+	}
+	return v, nil
+}
+
+func parseIntervalValue(k string) (time.Duration, error) {
+	m := intervalRegexp.FindStringSubmatch(k)
+	if m == nil || k == "P" || k == "-P" || strings.HasSuffix(k, "T") {
+		return 0, fmt.Errorf("invalid ISO 8601 interval %q", k)
+	}
+	var d time.Duration
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute}
+	for i, unit := range units {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(m[i+2], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(n) * unit
+	}
+	if m[6] != "" {
+		r, ok := new(big.Rat).SetString(m[6])
+		if !ok {
+			return 0, fmt.Errorf("invalid seconds in interval %q", k)
+		}
+		r.Mul(r, big.NewRat(int64(time.Second), 1))
+		d += time.Duration(r.Num().Int64() / r.Denom().Int64())
+	}
+	if m[1] != "" {
+		d = -d
+	}
+	return d, nil
+}
+
+func formatIntervalValue(d time.Duration) string {
+	buf := make([]byte, 0, 32)
+	if d < 0 {
+		buf = append(buf, '-')
+		d = -d
+	}
+	buf = append(buf, 'P')
+	if days := d / (24 * time.Hour); days > 0 {
+		d -= days * 24 * time.Hour
+		buf = strconv.AppendInt(buf, int64(days), 10)
+		buf = append(buf, 'D')
+	}
+	if d == 0 {
+		if len(buf) == 1 || (len(buf) == 2 && buf[0] == '-') {
+			buf = append(buf, "T0S"...)
+		}
+		return string(buf)
+	}
+	buf = append(buf, 'T')
+	if hours := d / time.Hour; hours > 0 {
+		d -= hours * time.Hour
+		buf = strconv.AppendInt(buf, int64(hours), 10)
+		buf = append(buf, 'H')
+	}
+	if minutes := d / time.Minute; minutes > 0 {
+		d -= minutes * time.Minute
+		buf = strconv.AppendInt(buf, int64(minutes), 10)
+		buf = append(buf, 'M')
+	}
+	if d > 0 {
+		buf = strconv.AppendInt(buf, int64(d/time.Second), 10)
+		if us := (d % time.Second) / time.Microsecond; us > 0 {
+			buf = append(buf, '.')
+			buf = append(buf, strings.TrimRight(fmt.Sprintf("%06d", us), "0")...)
+		}
+		buf = append(buf, 'S')
+	}
+	return string(buf)
+}
+
+// intBitSize returns bit size of integer column type.
+func intBitSize(typ string) int {
+	switch typ {
+	case "Int8", "Uint8":
+		return 8
+	case "Int16", "Uint16":
+		return 16
+	case "Int32", "Uint32":
+		return 32
+	}
+	return 64
+}
+
+// parseLiteral parses value of primary key column from its HCL representation.
+func parseLiteral(k string, typ string) (*Literal, error) {
+	t := unwrapOptionalType(typ)
+	l := &Literal{
+		Type: t,
+	}
+	switch {
+	case isIntColumn(t):
+		v, err := strconv.ParseInt(k, 10, intBitSize(t))
+		if err != nil {
+			return nil, err
+		}
+		l.Value = strconv.FormatInt(v, 10)
+	case isUintColumn(t):
+		v, err := strconv.ParseUint(k, 10, intBitSize(t))
+		if err != nil {
+			return nil, err
+		}
+		l.Value = strconv.FormatUint(v, 10)
+	case isFloatColumn(t):
+		v, err := strconv.ParseFloat(k, 64)
+		if err != nil {
+			return nil, err
+		}
+		l.Value = strconv.FormatFloat(v, 'g', -1, 64)
+	case isBoolColumn(t):
+		v, err := strconv.ParseBool(k)
+		if err != nil {
+			return nil, err
+		}
+		l.Value = strconv.FormatBool(v)
+	case isStringColumn(t) || isUTF8Column(t) || t == "DyNumber":
+		l.Value = k
+	case isDecimalColumn(t):
+		precision, scale, err := parseDecimalType(t)
+		if err != nil {
+			return nil, err
+		}
+		l.Value, err = parseDecimalValue(k, precision, scale)
+		if err != nil {
+			return nil, err
+		}
+	case isDateColumn(t):
+		v, err := time.Parse(layoutDate, k)
+		if err != nil {
+			return nil, err
+		}
+		l.Value = v.Format(layoutDate)
+	case isDatetimeColumn(t):
+		v, err := time.Parse(time.RFC3339Nano, k)
+		if err != nil {
+			return nil, err
+		}
+		if t == "Datetime" {
+			l.Value = v.UTC().Format(layoutDatetime)
+		} else {
+			l.Value = v.UTC().Format(layoutTimestamp)
+		}
+	case t == "Interval":
+		v, err := parseIntervalValue(k)
+		if err != nil {
+			return nil, err
+		}
+		l.Value = formatIntervalValue(v)
+	case t == "Uuid":
+		if !uuidRegexp.MatchString(k) {
+			return nil, fmt.Errorf("invalid uuid %q", k)
+		}
+		l.Value = strings.ToLower(k)
+	default:
+		return nil, fmt.Errorf("unknown column type %q", typ)
+	}
+	return l, nil
+}
+
+// unquoteYQLValue extracts "value" from `Type("value"...)` produced by go-sdk.
+func unquoteYQLValue(yql string) (string, error) {
+	begin := strings.IndexByte(yql, '"')
+	end := strings.LastIndexByte(yql, '"')
+	if begin == -1 || begin == end {
+		return "", fmt.Errorf("unexpected literal %q", yql)
+	}
+	return yql[begin+1 : end], nil
+}
+
+// formatLiteral converts value returned by server to its HCL representation.
+func formatLiteral(v types.Value, typ string) (string, error) {
+	t := unwrapOptionalType(typ)
+	switch {
+	case t == "Uint64":
+		var u uint64
+		if err := types.CastTo(v, &u); err != nil {
+			return "", err
+		}
+		return strconv.FormatUint(u, 10), nil
+	case isIntColumn(t) || isUintColumn(t) || isBoolColumn(t) ||
+		isStringColumn(t) || isUTF8Column(t) || isFloatColumn(t):
+		var s string
+		if err := types.CastTo(v, &s); err != nil {
+			return "", err
+		}
+		return s, nil
+	case isDateColumn(t) || isDatetimeColumn(t):
+		var tm time.Time
+		if err := types.CastTo(v, &tm); err != nil {
+			return "", err
+		}
+		switch t {
+		case "Date":
+			return tm.UTC().Format(layoutDate), nil
+		case "Datetime":
+			return tm.UTC().Format(layoutDatetime), nil
+		default:
+			return tm.UTC().Format(layoutTimestamp), nil
+		}
+	case t == "Interval":
+		var d time.Duration
+		if err := types.CastTo(v, &d); err != nil {
+			return "", err
+		}
+		return formatIntervalValue(d), nil
+	case isDecimalColumn(t) || t == "DyNumber":
+		return unquoteYQLValue(v.Yql())
+	case t == "Uuid":
+		s, err := unquoteYQLValue(v.Yql())
+		if err != nil {
+			return "", err
+		}
+		return strings.ToLower(s), nil
+	}
+	return "", fmt.Errorf("unknown column type %q", typ)
+}
+
+// sameLiteral reports whether two HCL representations of values of the given type are equal.
+func sameLiteral(a, b string, typ string) bool {
+	if a == b {
+		return true
+	}
+	la, err := parseLiteral(a, typ)
+	if err != nil {
+		return false
+	}
+	lb, err := parseLiteral(b, typ)
+	if err != nil {
+		return false
+	}
+	return la.Value == lb.Value
+}
@@ -0,0 +1,243 @@
+package table
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestParseLiteral(t *testing.T) {
+	testData := []struct {
+		testName    string
+		value       string
+		typ         string
+		expected    string
+		expectedErr bool
+	}{
+		{
+			testName: "uint64",
+			value:    "42",
+			typ:      "Uint64",
+			expected: "42",
+		},
+		{
+			testName:    "negative uint64",
+			value:       "-42",
+			typ:         "Uint64",
+			expectedErr: true,
+		},
+		{
+			testName:    "int8 out of range",
+			value:       "300",
+			typ:         "Int8",
+			expectedErr: true,
+		},
+		{
+			testName: "min int16",
+			value:    "-32768",
+			typ:      "Int16",
+			expected: "-32768",
+		},
+		{
+			testName:    "uint32 out of range",
+			value:       "4294967296",
+			typ:         "Uint32",
+			expectedErr: true,
+		},
+		{
+			testName: "max uint8",
+			value:    "255",
+			typ:      "Uint8",
+			expected: "255",
+		},
+		{
+			testName: "optional int32",
+			value:    "-5",
+			typ:      "Optional<Int32>",
+			expected: "-5",
+		},
+		{
+			testName: "utf8",
+			value:    "abacaba",
+			typ:      "Utf8",
+			expected: "\"abacaba\"u",
+		},
+		{
+			testName: "string with escape symbols",
+			value:    "a\"b",
+			typ:      "String",
+			expected: "\"a\\\"b\"",
+		},
+		{
+			testName: "double",
+			value:    "1.5",
+			typ:      "Double",
+			expected: "Double(\"1.5\")",
+		},
+		{
+			testName: "date",
+			value:    "2024-01-01",
+			typ:      "Date",
+			expected: "Date(\"2024-01-01\")",
+		},
+		{
+			testName:    "invalid date",
+			value:       "2024-13-01",
+			typ:         "Date",
+			expectedErr: true,
+		},
+		{
+			testName: "datetime with timezone",
+			value:    "2024-01-01T03:00:00+03:00",
+			typ:      "Datetime",
+			expected: "Datetime(\"2024-01-01T00:00:00Z\")",
+		},
+		{
+			testName: "timestamp",
+			value:    "2024-01-01T00:00:00.5Z",
+			typ:      "Timestamp",
+			expected: "Timestamp(\"2024-01-01T00:00:00.500000Z\")",
+		},
+		{
+			testName: "interval",
+			value:    "P1DT90M",
+			typ:      "Interval",
+			expected: "Interval(\"P1DT1H30M\")",
+		},
+		{
+			testName:    "interval with years",
+			value:       "P1Y",
+			typ:         "Interval",
+			expectedErr: true,
+		},
+		{
+			testName: "decimal",
+			value:    "1.5",
+			typ:      "Decimal(22,9)",
+			expected: "Decimal(\"1.500000000\", 22, 9)",
+		},
+		{
+			testName:    "decimal with too many fractional digits",
+			value:       "1.55",
+			typ:         "Decimal(5,1)",
+			expectedErr: true,
+		},
+		{
+			testName:    "decimal out of precision",
+			value:       "12345",
+			typ:         "Decimal(5,1)",
+			expectedErr: true,
+		},
+		{
+			testName: "uuid",
+			value:    "6F9619FF-8B86-D011-B42D-00CF4FC964FF",
+			typ:      "Uuid",
+			expected: "Uuid(\"6f9619ff-8b86-d011-b42d-00cf4fc964ff\")",
+		},
+		{
+			testName:    "invalid uuid",
+			value:       "6F9619FF",
+			typ:         "Uuid",
+			expectedErr: true,
+		},
+		{
+			testName:    "unknown type",
+			value:       "{}",
+			typ:         "Yson",
+			expectedErr: true,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			got, err := parseLiteral(v.value, v.typ)
+			if v.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, v.expected, got.ToYQL())
+		})
+	}
+}
+
+func TestFormatLiteral(t *testing.T) {
+	testData := []struct {
+		testName    string
+		value       types.Value
+		typ         string
+		expected    string
+		expectedErr bool
+	}{
+		{
+			testName: "uint64",
+			value:    types.Uint64Value(18446744073709551615),
+			typ:      "Uint64",
+			expected: "18446744073709551615",
+		},
+		{
+			testName: "optional int32",
+			value:    types.OptionalValue(types.Int32Value(-5)),
+			typ:      "Int32",
+			expected: "-5",
+		},
+		{
+			testName: "utf8",
+			value:    types.TextValue("abacaba"),
+			typ:      "Utf8",
+			expected: "abacaba",
+		},
+		{
+			testName: "date",
+			value:    types.DateValueFromTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+			typ:      "Date",
+			expected: "2024-01-01",
+		},
+		{
+			testName: "timestamp",
+			value:    types.TimestampValueFromTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+			typ:      "Timestamp",
+			expected: "2024-01-01T00:00:00.000000Z",
+		},
+		{
+			testName: "interval",
+			value:    types.IntervalValueFromDuration(26 * time.Hour),
+			typ:      "Interval",
+			expected: "P1DT2H",
+		},
+		{
+			testName: "uuid",
+			value:    types.UUIDValue([16]byte{0x6f, 0x96, 0x19, 0xff, 0x8b, 0x86, 0xd0, 0x11, 0xb4, 0x2d, 0x00, 0xcf, 0x4f, 0xc9, 0x64, 0xff}),
+			typ:      "Uuid",
+			expected: "6f9619ff-8b86-d011-b42d-00cf4fc964ff",
+		},
+		{
+			testName:    "unknown type",
+			value:       types.YSONValue("{}"),
+			typ:         "Yson",
+			expectedErr: true,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			got, err := formatLiteral(v.value, v.typ)
+			if v.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, v.expected, got)
+		})
+	}
+}
+
+func TestSameLiteral(t *testing.T) {
+	assert.True(t, sameLiteral("1.5", "1.500000000", "Decimal(22,9)"))
+	assert.True(t, sameLiteral("2024-01-01T00:00:00Z", "2024-01-01T00:00:00.000000Z", "Timestamp"))
+	assert.False(t, sameLiteral("1", "2", "Uint64"))
+}
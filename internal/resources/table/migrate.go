@@ -0,0 +1,168 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/indexed"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const (
+	migrationTmpSuffix    = "__migrate_tmp"
+	migrationBackupSuffix = "__migrate_old"
+	migrationBatchSize    = 1000
+)
+
+// migrateTable recreates the table with new primary key: the table is created under a temporary
+// path, rows are copied into it and then tables are swapped.
+// NOTE: rows written into the table during migration are not copied.
+func migrateTable(ctx context.Context, db ydb.Connection, oldPath string, columns []string, r *Resource) error {
+	tmp := *r
+	tmp.Path = r.Path + migrationTmpSuffix
+	tmp.FullPath = path.Join(db.Name(), tmp.Path)
+	err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		return s.ExecuteSchemeQuery(ctx, PrepareCreateRequest(&tmp))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create temporary table %q: %w", tmp.Path, err)
+	}
+
+	err = copyTableRows(ctx, db, path.Join(db.Name(), oldPath), tmp.FullPath, columns)
+	if err != nil {
+		err = fmt.Errorf("failed to copy rows into temporary table %q: %w", tmp.Path, err)
+		return dropTemporaryTable(ctx, db, tmp.Path, err)
+	}
+
+	backupPath := oldPath + migrationBackupSuffix
+	restored, err := swapTables(ctx, db, oldPath, backupPath, tmp.Path, r.Path)
+	if err != nil {
+		err = fmt.Errorf("failed to swap table %q with temporary table %q: %w", oldPath, tmp.Path, err)
+		if !restored {
+			// NOTE: both tables are kept, the previous one is under backupPath.
+			return err
+		}
+		return dropTemporaryTable(ctx, db, tmp.Path, err)
+	}
+
+	// NOTE: changefeeds are created after rows are copied, so copied rows are not written into them.
+	err = createChangefeeds(ctx, db, r.Changefeeds)
+	if err != nil {
+		return err
+	}
+
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		return s.ExecuteSchemeQuery(ctx, PrepareDropTableRequest(backupPath))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to drop previous table %q: %w", backupPath, err)
+	}
+	return nil
+}
+
+// swapTables moves the table into backupPath and the temporary table into newPath.
+// NOTE: renames are separate scheme operations and are not atomic, the table is absent for a short
+// time between them. If the second rename fails, the table is moved back, so the migration can be
+// applied again from the start. restored reports whether the table is at oldPath after a failure.
+func swapTables(ctx context.Context, db ydb.Connection, oldPath, backupPath, tmpPath, newPath string) (restored bool, err error) {
+	rename := func(from, to string) error {
+		return db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			return s.ExecuteSchemeQuery(ctx, prepareRenameTableQuery(from, to))
+		})
+	}
+	err = rename(oldPath, backupPath)
+	if err != nil {
+		return true, err
+	}
+	err = rename(tmpPath, newPath)
+	if err == nil {
+		return false, nil
+	}
+	if rollbackErr := rename(backupPath, oldPath); rollbackErr != nil {
+		return false, fmt.Errorf("%w; failed to move table back from %q: %s", err, backupPath, rollbackErr)
+	}
+	return true, err
+}
+
+// dropTemporaryTable drops the temporary table after failed migration, so the next apply can
+// create it again. cause is returned together with the error of drop.
+func dropTemporaryTable(ctx context.Context, db ydb.Connection, tmpPath string, cause error) error {
+	err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		return s.ExecuteSchemeQuery(ctx, PrepareDropTableRequest(tmpPath))
+	})
+	if err != nil {
+		return fmt.Errorf("%w; failed to drop temporary table %q: %s", cause, tmpPath, err)
+	}
+	return cause
+}
+
+// migratedColumns returns names of columns which exist both in the previous and in the new table,
+// columns dropped in the same change are not copied.
+func migratedColumns(oldColumns, newColumns []*Column) []string {
+	declared := make(map[string]struct{}, len(newColumns))
+	for _, v := range newColumns {
+		declared[v.Name] = struct{}{}
+	}
+	columns := make([]string, 0, len(oldColumns))
+	for _, v := range oldColumns {
+		if _, ok := declared[v.Name]; ok {
+			columns = append(columns, v.Name)
+		}
+	}
+	return columns
+}
+
+// copyTableRows copies rows with read table stream and bulk upserts by batches.
+func copyTableRows(ctx context.Context, db ydb.Connection, src, dst string, columns []string) error {
+	return db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		opts := make([]options.ReadTableOption, 0, len(columns))
+		for _, v := range columns {
+			opts = append(opts, options.ReadColumn(v))
+		}
+		res, err := s.StreamReadTable(ctx, src, opts...)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+
+		values := make([]types.Value, len(columns))
+		scanValues := make([]indexed.RequiredOrOptional, len(columns))
+		for i := range values {
+			scanValues[i] = &values[i]
+		}
+
+		rows := make([]types.Value, 0, migrationBatchSize)
+		for res.NextResultSet(ctx, columns...) {
+			for res.NextRow() {
+				if err = res.Scan(scanValues...); err != nil {
+					return err
+				}
+				fields := make([]types.StructValueOption, 0, len(columns))
+				for i, v := range columns {
+					fields = append(fields, types.StructFieldValue(v, values[i]))
+				}
+				rows = append(rows, types.StructValue(fields...))
+				if len(rows) < migrationBatchSize {
+					continue
+				}
+				if err = s.BulkUpsert(ctx, dst, types.ListValue(rows...)); err != nil {
+					return err
+				}
+				rows = rows[:0]
+			}
+		}
+		if err = res.Err(); err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return s.BulkUpsert(ctx, dst, types.ListValue(rows...))
+	})
+}
@@ -0,0 +1,42 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigratedColumns(t *testing.T) {
+	oldColumns := []*Column{
+		{Name: "a", Type: "Uint64"},
+		{Name: "b", Type: "Utf8"},
+		{Name: "c", Type: "Timestamp"},
+	}
+	testData := []struct {
+		testName   string
+		newColumns []*Column
+		expected   []string
+	}{
+		{
+			testName:   "same columns",
+			newColumns: oldColumns,
+			expected:   []string{"a", "b", "c"},
+		},
+		{
+			testName: "column is dropped and added",
+			newColumns: []*Column{
+				{Name: "c", Type: "Timestamp"},
+				{Name: "a", Type: "Uint64"},
+				{Name: "d", Type: "Utf8"},
+			},
+			expected: []string{"a", "c"},
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			assert.Equal(t, v.expected, migratedColumns(oldColumns, v.newColumns))
+		})
+	}
+}
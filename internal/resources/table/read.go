@@ -2,12 +2,17 @@ package table
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Table_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
-	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 
 	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
 )
@@ -43,17 +48,7 @@ func (h *handler) Read(ctx context.Context, d *schema.ResourceData, cfg interfac
 		_ = db.Close(ctx)
 	}()
 
-	var description options.Description
-	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
-		description, err = s.DescribeTable(
-			ctx,
-			tableResource.Entity.GetFullEntityPath(),
-			options.WithPartitionStats(),
-			options.WithShardKeyBounds(),
-			options.WithTableStats(),
-		)
-		return err
-	})
+	description, err := DescribeTable(ctx, db, tableResource.Entity.GetFullEntityPath())
 	if err != nil {
 		if strings.Contains(err.Error(), "SCHEME_ERROR") {
 			// NOTE(shmel1k@): marking as non-existing resource
@@ -63,5 +58,81 @@ func (h *handler) Read(ctx context.Context, d *schema.ResourceData, cfg interfac
 		return diag.Errorf("failed to describe table %q: %s", tableResource.Path, err)
 	}
 
-	return diag.FromErr(flattenTableDescription(d, description, tableResource.Entity))
+	storeType, err := DescribeStoreType(ctx, db, tableResource.Entity.GetFullEntityPath())
+	if err != nil {
+		return diag.Errorf("failed to describe path of table %q: %s", tableResource.Path, err)
+	}
+
+	consumers, err := describeChangefeedConsumers(
+		ctx,
+		db,
+		tableResource.Entity.GetEntityPath(),
+		declaredChangefeeds(d, tableResource.Entity.GetEntityPath(), description.Changefeeds),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// NOTE: columns are empty in the prior state only on import, then every index of the table
+	// is read back, there are no declared indexes to tell them from ydb_table_index resources.
+	imported := d.Get("column").(*schema.Set).Len() == 0
+	err = FlattenTableDescription(d, description, tableResource.Entity, storeType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if imported {
+		types, err := describeIndexTypes(ctx, db, tableResource.Entity.GetFullEntityPath())
+		if err != nil {
+			return diag.Errorf("failed to describe indexes of table %q: %s", tableResource.Path, err)
+		}
+		err = d.Set("index", flattenAllIndexes(description.Indexes, types))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return diag.FromErr(d.Set("changefeed", flattenChangefeeds(d, description.Changefeeds, consumers)))
+}
+
+func DescribeStoreType(ctx context.Context, db ydb.Connection, path string) (string, error) {
+	entry, err := db.Scheme().DescribePath(ctx, path)
+	if err != nil {
+		return "", err
+	}
+	if entry.Type == scheme.EntryColumnTable {
+		return storeTypeColumn, nil
+	}
+	return storeTypeRow, nil
+}
+
+// describeIndexTypes returns types of the table indexes by name, the type is not returned by
+// DescribeTable of the SDK.
+func describeIndexTypes(ctx context.Context, db ydb.Connection, fullPath string) (map[string]string, error) {
+	var result Ydb_Table.DescribeTableResult
+	err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		client := Ydb_Table_V1.NewTableServiceClient(ydb.GRPCConn(db))
+		response, err := client.DescribeTable(ctx, &Ydb_Table.DescribeTableRequest{
+			SessionId: s.ID(),
+			Path:      fullPath,
+		})
+		if err != nil {
+			return err
+		}
+		operation := response.GetOperation()
+		if operation.GetStatus() != Ydb.StatusIds_SUCCESS {
+			return fmt.Errorf("operation failed with status %s: %v", operation.GetStatus(), operation.GetIssues())
+		}
+		return operation.GetResult().UnmarshalTo(&result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	types := make(map[string]string, len(result.GetIndexes()))
+	for _, v := range result.GetIndexes() {
+		if v.GetGlobalAsyncIndex() != nil {
+			types[v.GetName()] = indexTypeGlobalAsync
+		} else {
+			types[v.GetName()] = indexTypeGlobalSync
+		}
+	}
+	return types, nil
 }
@@ -3,6 +3,7 @@ package table
 import (
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 
 	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers/yqltypes"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/changefeed"
 )
 
 type Column struct {
@@ -44,6 +47,16 @@ type PrimaryKey struct {
 	Columns []string
 }
 
+const (
+	indexTypeGlobalSync  = "global_sync"
+	indexTypeGlobalAsync = "global_async"
+)
+
+const (
+	storeTypeRow    = "row"
+	storeTypeColumn = "column"
+)
+
 type Index struct {
 	Name    string
 	Type    string
@@ -51,17 +64,100 @@ type Index struct {
 	Cover   []string
 }
 
-type TTL struct {
-	ColumnName     string
+func (i *Index) ToYQL() string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "INDEX `"...)
+	buf = helpers.AppendWithEscape(buf, i.Name)
+	buf = append(buf, '`', ' ')
+	if i.Type == indexTypeGlobalAsync {
+		buf = append(buf, "GLOBAL ASYNC ON ("...)
+	} else {
+		buf = append(buf, "GLOBAL SYNC ON ("...)
+	}
+	for j, c := range i.Columns {
+		buf = append(buf, '`')
+		buf = helpers.AppendWithEscape(buf, c)
+		buf = append(buf, '`')
+		if j != len(i.Columns)-1 {
+			buf = append(buf, ',', ' ')
+		}
+	}
+	buf = append(buf, ')')
+	if len(i.Cover) > 0 {
+		buf = append(buf, " COVER ("...)
+		for j, c := range i.Cover {
+			buf = append(buf, '`')
+			buf = helpers.AppendWithEscape(buf, c)
+			buf = append(buf, '`')
+			if j != len(i.Cover)-1 {
+				buf = append(buf, ',', ' ')
+			}
+		}
+		buf = append(buf, ')')
+	}
+	return string(buf)
+}
+
+type TTLTier struct {
 	ExpireInterval string
+	// Empty ExternalDataSource means that expired rows are deleted.
+	ExternalDataSource string
 }
 
-func (t *TTL) ToYQL() string {
+func (t *TTLTier) ToYQL() string {
 	buf := make([]byte, 0, 64)
-	buf = append(buf, "TTL = Interval(\""...)
+	buf = append(buf, "Interval(\""...)
 	buf = helpers.AppendWithEscape(buf, t.ExpireInterval)
 	buf = append(buf, '"')
 	buf = append(buf, ')')
+	if t.ExternalDataSource == "" {
+		buf = append(buf, " DELETE"...)
+		return string(buf)
+	}
+	buf = append(buf, " TO EXTERNAL DATA SOURCE "...)
+	buf = append(buf, '`')
+	buf = helpers.AppendWithEscape(buf, t.ExternalDataSource)
+	buf = append(buf, '`')
+	return string(buf)
+}
+
+type TTL struct {
+	ColumnName     string
+	ExpireInterval string
+	Tiers          []*TTLTier
+}
+
+// validate checks that exactly one of expire_interval and tiers is set and only the last tier deletes rows.
+func (t *TTL) validate() error {
+	if t.ExpireInterval != "" && len(t.Tiers) > 0 {
+		return fmt.Errorf("ttl.0: `expire_interval` and `tier` can not be used together")
+	}
+	if t.ExpireInterval == "" && len(t.Tiers) == 0 {
+		return fmt.Errorf("ttl.0: either `expire_interval` or `tier` must be set")
+	}
+	for i, v := range t.Tiers {
+		if v.ExternalDataSource == "" && i != len(t.Tiers)-1 {
+			return fmt.Errorf("ttl.0.tier.%d: only the last tier can delete rows", i)
+		}
+	}
+	return nil
+}
+
+func (t *TTL) ToYQL() string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "TTL = "...)
+	if len(t.Tiers) == 0 {
+		buf = append(buf, "Interval(\""...)
+		buf = helpers.AppendWithEscape(buf, t.ExpireInterval)
+		buf = append(buf, '"')
+		buf = append(buf, ')')
+	}
+	for i, v := range t.Tiers {
+		buf = append(buf, v.ToYQL()...)
+		if i != len(t.Tiers)-1 {
+			buf = append(buf, ',', ' ')
+		}
+	}
 	buf = append(buf, " ON "...)
 	buf = append(buf, '`')
 	buf = helpers.AppendWithEscape(buf, t.ColumnName)
@@ -70,7 +166,7 @@ func (t *TTL) ToYQL() string {
 }
 
 type PartitionAtKeys struct {
-	Keys []interface{}
+	Keys []*Literal
 }
 
 type PartitioningSettings struct {
@@ -82,8 +178,24 @@ type PartitioningSettings struct {
 	MaxPartitionsCount int
 }
 
+const (
+	readReplicasModePerAZ = "PER_AZ"
+	readReplicasModeAnyAZ = "ANY_AZ"
+)
+
 type ReplicationSettings struct {
-	ReadReplicasSettings string
+	Mode  string
+	Count int
+}
+
+func (r *ReplicationSettings) ToYQL() string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "READ_REPLICAS_SETTINGS = \""...)
+	buf = append(buf, r.Mode...)
+	buf = append(buf, ':')
+	buf = strconv.AppendInt(buf, int64(r.Count), 10)
+	buf = append(buf, '"')
+	return string(buf)
 }
 
 type Family struct {
@@ -102,10 +214,18 @@ type Resource struct {
 	Family               []*Family
 	Columns              []*Column
 	PrimaryKey           *PrimaryKey
+	Indexes              []*Index
+	Changefeeds          []*changefeed.ChangeDataCaptureSettings
 	TTL                  *TTL
 	ReplicationSettings  *ReplicationSettings
 	PartitioningSettings *PartitioningSettings
 	EnableBloomFilter    *bool
+	StoreType            string
+	PartitionByHash      []string
+}
+
+func (r *Resource) isColumnTable() bool {
+	return r.StoreType == storeTypeColumn
 }
 
 func (r *Resource) getConnectionString() string {
@@ -115,7 +235,22 @@ func (r *Resource) getConnectionString() string {
 	return r.Entity.PrepareFullYDBEndpoint()
 }
 
-func expandTableTTLSettings(d *schema.ResourceData) (ttl *TTL) {
+func expandTableTTLTiers(tiersRaw []interface{}) []*TTLTier {
+	tiers := make([]*TTLTier, 0, len(tiersRaw))
+	for _, v := range tiersRaw {
+		m := v.(map[string]interface{})
+		tier := &TTLTier{
+			ExpireInterval: m["expire_interval"].(string),
+		}
+		if src, ok := m["external_data_source"].(string); ok {
+			tier.ExternalDataSource = src
+		}
+		tiers = append(tiers, tier)
+	}
+	return tiers
+}
+
+func expandTableTTLSettings(d *schema.ResourceData) (ttl *TTL, err error) {
 	v, ok := d.GetOk("ttl")
 	if !ok {
 		return
@@ -127,18 +262,28 @@ func expandTableTTLSettings(d *schema.ResourceData) (ttl *TTL) {
 		ttl.ColumnName = m["column_name"].(string)
 		//		ttl.Mode = m["mode"].(string)
 		ttl.ExpireInterval = m["expire_interval"].(string)
+		if tiersRaw, ok := m["tier"].([]interface{}); ok && len(tiersRaw) > 0 {
+			ttl.Tiers = expandTableTTLTiers(tiersRaw)
+		}
+		if err = ttl.validate(); err != nil {
+			return nil, err
+		}
 	}
 	return
 }
 
 func expandTableReplicasSettings(d *schema.ResourceData) (p *ReplicationSettings) {
-	v, ok := d.GetOk("read_replicas_settings")
+	v, ok := d.GetOk("read_replicas")
 	if !ok {
 		return
 	}
-
-	p = &ReplicationSettings{}
-	p.ReadReplicasSettings = v.(string)
+	for _, l := range v.([]interface{}) {
+		m := l.(map[string]interface{})
+		p = &ReplicationSettings{
+			Mode:  m["mode"].(string),
+			Count: m["count"].(int),
+		}
+	}
 	return
 }
 
@@ -156,9 +301,9 @@ func expandPartitionAtKeys(p []interface{}, primaryKeyColumns []*Column) ([]*Par
 			if i == len(primaryKeyColumns) {
 				return nil, fmt.Errorf("can not be more partition keys than primary key columns")
 			}
-			got, err := parsePartitionKey(k.(string), primaryKeyColumns[i].Type)
+			got, err := parseLiteral(k.(string), primaryKeyColumns[i].Type)
 			if err != nil {
-				return nil, err
+				return nil, fmt.Errorf("failed to parse partition key for column %q: %w", primaryKeyColumns[i].Name, err)
 			}
 			pp.Keys = append(pp.Keys, got)
 		}
@@ -174,16 +319,26 @@ func expandTablePartitioningPolicySettings(d *schema.ResourceData, columns []*Co
 	}
 
 	p = &PartitioningSettings{}
+	if d.Get("store").(string) == storeTypeColumn {
+		// NOTE: column tables are partitioned by hash, only number of partitions can be set.
+		for _, l := range v.(*schema.Set).List() {
+			m := l.(map[string]interface{})
+			if minPartitionsCount, ok := m["auto_partitioning_min_partitions_count"].(int); ok {
+				p.MinPartitionsCount = minPartitionsCount
+			}
+		}
+		return p, nil
+	}
 
-	pk := make(map[string]struct{})
-	for _, v := range primaryKeyColumns {
-		pk[v] = struct{}{}
+	cols := make(map[string]*Column, len(columns))
+	for _, v := range columns {
+		cols[v.Name] = v
 	}
 
 	primaryKeyCols := make([]*Column, 0, len(primaryKeyColumns))
-	for _, v := range columns {
-		if _, ok := pk[v.Name]; ok {
-			primaryKeyCols = append(primaryKeyCols, v)
+	for _, v := range primaryKeyColumns {
+		if col, ok := cols[v]; ok {
+			primaryKeyCols = append(primaryKeyCols, col)
 		}
 	}
 
@@ -208,7 +363,7 @@ func expandTablePartitioningPolicySettings(d *schema.ResourceData, columns []*Co
 		if byLoad, ok := m["auto_partitioning_by_load"].(bool); ok {
 			p.ByLoad = &byLoad
 		}
-		if bySize, ok := m["auto_partitioning_partition_size_mb"].(int); ok {
+		if bySize, ok := m["auto_partitioning_partition_size_mb"].(int); ok && (bySize != 0 || partitionSizeConfigured(d)) {
 			p.BySize = &bySize
 		}
 	}
@@ -216,6 +371,29 @@ func expandTablePartitioningPolicySettings(d *schema.ResourceData, columns []*Co
 	return p, nil
 }
 
+// partitionSizeConfigured reports whether auto_partitioning_partition_size_mb is set in configuration,
+// explicit zero disables partitioning by size.
+func partitionSizeConfigured(d *schema.ResourceData) bool {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return false
+	}
+	settings := raw.GetAttr("partitioning_settings")
+	if settings.IsNull() || !settings.IsKnown() {
+		return false
+	}
+	for it := settings.ElementIterator(); it.Next(); {
+		_, v := it.Element()
+		if v.IsNull() || !v.IsKnown() {
+			continue
+		}
+		if !v.GetAttr("auto_partitioning_partition_size_mb").IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
 func tableResourceSchemaToTableResource(d *schema.ResourceData) (*Resource, error) {
 	var entity *helpers.YDBEntity
 	var err error
@@ -228,9 +406,13 @@ func tableResourceSchemaToTableResource(d *schema.ResourceData) (*Resource, erro
 
 	columns := expandColumns(d.Get("column"))
 	pk := expandPrimaryKey(d)
+	indexes := expandIndexes(d.Get("index"))
 	families := expandColumnFamilies(d)
 	attributes := expandAttributes(d)
-	ttl := expandTableTTLSettings(d)
+	ttl, err := expandTableTTLSettings(d)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand table ttl settings: %w", err)
+	}
 
 	databaseEndpoint := d.Get("connection_string").(string)
 	databaseURL, err := url.Parse(databaseEndpoint)
@@ -268,55 +450,208 @@ func tableResourceSchemaToTableResource(d *schema.ResourceData) (*Resource, erro
 		DatabaseEndpoint: databaseEndpoint,
 		Attributes:       attributes,
 		Family:           families,
-		// ChangeFeeds:      cdcSettings,
-		Columns: columns,
+		Changefeeds:      expandChangefeeds(d.Get("path").(string), d.Get("changefeed")),
+		Columns:          columns,
 		PrimaryKey: &PrimaryKey{
 			Columns: pk,
 		},
+		Indexes:              indexes,
 		TTL:                  ttl,
 		PartitioningSettings: partitioningSettings,
 		ReplicationSettings:  replicasSettings,
 		EnableBloomFilter:    bloomFilterEnabled,
+		StoreType:            d.Get("store").(string),
+		PartitionByHash:      expandPartitionByHash(d),
 	}, nil
 }
 
-func flattenTablePartitioningSettings(d *schema.ResourceData, settings options.PartitioningSettings) []interface{} {
+func primaryKeyColumnTypes(desc options.Description) []string {
+	columnTypes := make(map[string]string, len(desc.Columns))
+	for _, col := range desc.Columns {
+		columnTypes[col.Name], _ = unwrapType(col.Type)
+	}
+	result := make([]string, 0, len(desc.PrimaryKey))
+	for _, v := range desc.PrimaryKey {
+		result = append(result, columnTypes[v])
+	}
+	return result
+}
+
+// keepEquivalentPartitionAtKeys keeps keys from state if they are the same values written differently,
+// e.g. "1.5" and "1.500000000" for Decimal(22,9).
+func keepEquivalentPartitionAtKeys(old []interface{}, got []interface{}, columnTypes []string) {
+	for i := 0; i < len(old) && i < len(got); i++ {
+		oldKeys := old[i].(map[string]interface{})["keys"].([]interface{})
+		gotKeys := got[i].(map[string]interface{})["keys"].([]interface{})
+		if len(oldKeys) != len(gotKeys) {
+			continue
+		}
+		same := true
+		for j := range gotKeys {
+			if !sameLiteral(oldKeys[j].(string), gotKeys[j].(string), columnTypes[j]) {
+				same = false
+				break
+			}
+		}
+		if same {
+			got[i] = old[i]
+		}
+	}
+}
+
+func flattenPartitionAtKeys(desc options.Description) ([]interface{}, error) {
+	columnTypes := primaryKeyColumnTypes(desc)
+
+	// NOTE: first key range starts from -inf, so split points are the left bounds of the others.
+	result := make([]interface{}, 0, len(desc.KeyRanges))
+	for i := 1; i < len(desc.KeyRanges); i++ {
+		if desc.KeyRanges[i].From == nil {
+			continue
+		}
+		items, err := types.TupleItems(desc.KeyRanges[i].From)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get partition key items: %w", err)
+		}
+		keys := make([]interface{}, 0, len(items))
+		for j, item := range items {
+			if j == len(desc.PrimaryKey) {
+				return nil, fmt.Errorf("got more partition keys than primary key columns")
+			}
+			if isNullValue(item) {
+				break
+			}
+			key, err := formatLiteral(item, columnTypes[j])
+			if err != nil {
+				return nil, fmt.Errorf("failed to format partition key for column %q: %w", desc.PrimaryKey[j], err)
+			}
+			keys = append(keys, key)
+		}
+		result = append(result, map[string]interface{}{
+			"keys": keys,
+		})
+	}
+	return result, nil
+}
+
+func flattenTablePartitioningSettings(d *schema.ResourceData, desc options.Description) ([]interface{}, error) {
+	settings := desc.PartitioningSettings
 	output := make([]interface{}, 0, 1)
 	partitioningSettings := make(map[string]interface{})
 	partitioningSettings["auto_partitioning_by_load"] = settings.PartitioningByLoad == options.FeatureEnabled
 	partitioningSettings["auto_partitioning_partition_size_mb"] = settings.PartitionSizeMb
+	if settings.PartitioningBySize == options.FeatureDisabled {
+		partitioningSettings["auto_partitioning_partition_size_mb"] = 0
+	}
 	partitioningSettings["auto_partitioning_min_partitions_count"] = settings.MinPartitionsCount
 	partitioningSettings["auto_partitioning_max_partitions_count"] = settings.MaxPartitionsCount
+	partitionAtKeys, err := flattenPartitionAtKeys(desc)
+	if err != nil {
+		return nil, err
+	}
+	// NOTE: uniform_partitions is a creation-time hint and is not returned by the server.
 	pSet := d.Get("partitioning_settings").(*schema.Set)
 	for _, l := range pSet.List() {
 		m := l.(map[string]interface{})
-		partitioningSettings["partition_at_keys"] = m["partition_at_keys"]
 		partitioningSettings["uniform_partitions"] = m["uniform_partitions"]
+		if old, ok := m["partition_at_keys"].([]interface{}); ok {
+			keepEquivalentPartitionAtKeys(old, partitionAtKeys, primaryKeyColumnTypes(desc))
+		}
 	}
+	partitioningSettings["partition_at_keys"] = partitionAtKeys
 
 	output = append(output, partitioningSettings)
-	return output
+	return output, nil
+}
+
+// flattenColumnTablePartitioning flattens partitioning of column table, partition_by_hash
+// is not returned by the server and is kept as is.
+func flattenColumnTablePartitioning(d *schema.ResourceData, desc options.Description) error {
+	return d.Set("partitioning_settings", []interface{}{
+		map[string]interface{}{
+			"auto_partitioning_min_partitions_count": desc.PartitioningSettings.MinPartitionsCount,
+		},
+	})
 }
 
+func flattenTableTTLSettings(d *schema.ResourceData, settings *options.TimeToLiveSettings) []interface{} {
+	ttlSettings := map[string]interface{}{
+		"column_name":     settings.ColumnName,
+		"expire_interval": ttlToISO8601(time.Duration(settings.ExpireAfterSeconds) * time.Second),
+	}
+	// NOTE: neither go-sdk nor the API protos describe TTL tiers yet, so tiers are kept as they are
+	// in state and their drift is not detected. expire_interval can not be set together with tiers,
+	// the server reports only the column of tiered TTL correctly.
+	ttlSet := d.Get("ttl").(*schema.Set)
+	for _, l := range ttlSet.List() {
+		m := l.(map[string]interface{})
+		if tiers, ok := m["tier"].([]interface{}); ok && len(tiers) > 0 {
+			ttlSettings["expire_interval"] = ""
+			ttlSettings["tier"] = tiers
+		}
+	}
+
+	return []interface{}{ttlSettings}
+}
+
+// optionalTypePrefix is the prefix of Yql() of optional types in go-sdk.
+const optionalTypePrefix = "Optional<"
+
 func unwrapType(t types.Type) (typ string, notNull bool) {
-	yqlStr := t.Yql()
-	notNull = true
+	parsed, err := yqltypes.Parse(t.Yql())
+	if err == nil {
+		return parsed.Inner().String(), !parsed.Optional
+	}
+	// NOTE: column has type unknown to the provider, it is kept as is without the optional wrapper.
+	typ = t.Yql()
+	if strings.HasPrefix(typ, optionalTypePrefix) && strings.HasSuffix(typ, ">") {
+		return typ[len(optionalTypePrefix) : len(typ)-1], false
+	}
+	return typ, true
+}
 
-	if strings.HasPrefix(yqlStr, "Optional<") {
-		notNull = false
-		yqlStr = strings.TrimPrefix(yqlStr, "Optional<")
-		yqlStr = strings.TrimSuffix(yqlStr, ">")
+// flattenIndexes flattens only indexes declared in the resource, indexes
+// managed by separate ydb_table_index resources are skipped.
+func flattenIndexes(d *schema.ResourceData, indexes []options.IndexDescription) []interface{} {
+	declared := make(map[string]*Index)
+	for _, v := range expandIndexes(d.Get("index")) {
+		declared[v.Name] = v
 	}
 
-	typ = yqlStr
-	if typ == "String" { //nolint
-		typ = "Bytes" //nolint
+	result := make([]interface{}, 0, len(declared))
+	for _, v := range indexes {
+		idx, ok := declared[v.Name]
+		if !ok {
+			continue
+		}
+		// NOTE: index type is not returned by DescribeTable.
+		result = append(result, flattenIndex(v, idx.Type))
 	}
+	return result
+}
 
-	return typ, notNull
+func flattenIndex(index options.IndexDescription, typ string) map[string]interface{} {
+	cols := make([]interface{}, 0, len(index.IndexColumns))
+	for _, c := range index.IndexColumns {
+		cols = append(cols, c)
+	}
+	cover := make([]interface{}, 0, len(index.DataColumns))
+	for _, c := range index.DataColumns {
+		cover = append(cover, c)
+	}
+	return map[string]interface{}{
+		"name":    index.Name,
+		"type":    typ,
+		"columns": cols,
+		"cover":   cover,
+	}
 }
 
-func flattenTableDescription(d *schema.ResourceData, desc options.Description, entity *helpers.YDBEntity) (err error) {
+func FlattenTableDescription(
+	d *schema.ResourceData,
+	desc options.Description,
+	entity *helpers.YDBEntity,
+	storeType string,
+) (err error) {
 	err = d.Set("path", entity.GetEntityPath())
 	if err != nil {
 		return
@@ -348,16 +683,18 @@ func flattenTableDescription(d *schema.ResourceData, desc options.Description, e
 		return
 	}
 
+	err = d.Set("index", flattenIndexes(d, desc.Indexes))
+	if err != nil {
+		return
+	}
+
+	ttlSettings := []interface{}{}
 	if desc.TimeToLiveSettings != nil {
-		var ttlSettings []interface{}
-		ttlSettings = append(ttlSettings, map[string]interface{}{
-			"column_name":     desc.TimeToLiveSettings.ColumnName,
-			"expire_interval": ttlToISO8601(time.Duration(desc.TimeToLiveSettings.ExpireAfterSeconds) * time.Second),
-		})
-		err = d.Set("ttl", ttlSettings)
-		if err != nil {
-			return
-		}
+		ttlSettings = flattenTableTTLSettings(d, desc.TimeToLiveSettings)
+	}
+	err = d.Set("ttl", ttlSettings)
+	if err != nil {
+		return
 	}
 
 	attributes := make(map[string]interface{})
@@ -368,7 +705,27 @@ func flattenTableDescription(d *schema.ResourceData, desc options.Description, e
 	if err != nil {
 		return
 	}
-	err = d.Set("partitioning_settings", flattenTablePartitioningSettings(d, desc.PartitioningSettings))
+	err = d.Set("store", storeType)
+	if err != nil {
+		return
+	}
+	err = flattenTableStats(d, desc.Stats)
+	if err != nil {
+		return
+	}
+	err = d.Set("current_partitions_count", currentPartitionsCount(desc))
+	if err != nil {
+		return
+	}
+	if storeType == storeTypeColumn {
+		return flattenColumnTablePartitioning(d, desc)
+	}
+
+	partitioningSettings, err := flattenTablePartitioningSettings(d, desc)
+	if err != nil {
+		return fmt.Errorf("failed to flatten partitioning settings: %w", err)
+	}
+	err = d.Set("partitioning_settings", partitioningSettings)
 	if err != nil {
 		return
 	}
@@ -377,10 +734,65 @@ func flattenTableDescription(d *schema.ResourceData, desc options.Description, e
 	if err != nil {
 		return
 	}
-	if desc.ReadReplicaSettings.Type == options.ReadReplicasAnyAzReadReplicas {
-		err = d.Set("read_replicas_settings", fmt.Sprintf("ANY_AZ:%d", desc.ReadReplicaSettings.Count))
-	} else {
-		err = d.Set("read_replicas_settings", fmt.Sprintf("PER_AZ:%d", desc.ReadReplicaSettings.Count))
+	return d.Set("read_replicas", flattenReadReplicasSettings(desc.ReadReplicaSettings))
+}
+
+// flattenTableStats sets computed table statistics, they are estimates refreshed by the server periodically.
+func flattenTableStats(d *schema.ResourceData, stats *options.TableStats) error {
+	if stats == nil {
+		return nil
+	}
+	partitionStats := make([]interface{}, 0, len(stats.PartitionStats))
+	for _, v := range stats.PartitionStats {
+		partitionStats = append(partitionStats, map[string]interface{}{
+			"rows_estimate":    int(v.RowsEstimate),
+			"store_size_bytes": int(v.StoreSize),
+		})
+	}
+	values := map[string]interface{}{
+		"rows_estimate":     int(stats.RowsEstimate),
+		"store_size_bytes":  int(stats.StoreSize),
+		"partitions_count":  int(stats.Partitions),
+		"creation_time":     formatStatsTime(stats.CreationTime),
+		"modification_time": formatStatsTime(stats.ModificationTime),
+		"partition_stats":   partitionStats,
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// currentPartitionsCount returns the number of table partitions, key ranges are not returned
+// for column tables, so statistics are used when they are requested.
+func currentPartitionsCount(desc options.Description) int {
+	if desc.Stats != nil {
+		return int(desc.Stats.Partitions)
+	}
+	return len(desc.KeyRanges)
+}
+
+func formatStatsTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func flattenReadReplicasSettings(settings options.ReadReplicasSettings) []interface{} {
+	if settings.Count == 0 {
+		return []interface{}{}
+	}
+	mode := readReplicasModePerAZ
+	if settings.Type == options.ReadReplicasAnyAzReadReplicas {
+		mode = readReplicasModeAnyAZ
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"mode":  mode,
+			"count": int(settings.Count),
+		},
 	}
-	return err
 }
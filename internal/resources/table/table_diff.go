@@ -3,6 +3,7 @@ package table
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,13 +11,18 @@ import (
 )
 
 type tableDiff struct {
-	TableName                 string
-	ColumnsToAdd              []*Column
-	NewTTLSettings            *TTL
-	NewPartitioningSettings   *PartitioningSettings
-	NewKeyBloomFilterSettings *bool
-	ReadReplicasSettings      string
-	OnlyResetTTL              bool
+	TableName               string
+	ColumnsToAdd            []*Column
+	IndexesToDrop           []string
+	IndexesToCreate         []*Index
+	NewTTLSettings          *TTL
+	NewPartitioningSettings *PartitioningSettings
+	// PartitioningSettingsToReset contains names of partitioning settings reset to their defaults.
+	PartitioningSettingsToReset []string
+	NewKeyBloomFilterSettings   *bool
+	NewReadReplicasSettings     *ReplicationSettings
+	ResetReadReplicas           bool
+	OnlyResetTTL                bool
 }
 
 func checkColumnDiff(rcolumns []*Column, dcolumns []*Column) ([]*Column, error) {
@@ -79,9 +85,9 @@ func compareIndexes(ridx *Index, didx options.IndexDescription) bool {
 }
 
 func checkIndexDiff(rindexes []*Index, dindexes []options.IndexDescription) (toDrop []string, toCreate []*Index) {
-	existingIndexes := make(map[string]struct{})
+	existingIndexes := make(map[string]options.IndexDescription)
 	for _, v := range dindexes {
-		existingIndexes[v.Name] = struct{}{}
+		existingIndexes[v.Name] = v
 	}
 
 	resourceIndexes := make(map[string]*Index)
@@ -96,9 +102,9 @@ func checkIndexDiff(rindexes []*Index, dindexes []options.IndexDescription) (toD
 	}
 
 	for k, v := range resourceIndexes {
-		if _, ok := existingIndexes[k]; !ok {
+		if existing, ok := existingIndexes[k]; !ok {
 			toCreate = append(toCreate, v)
-		} else {
+		} else if !compareIndexes(v, existing) {
 			toCreate = append(toCreate, v)
 			toDrop = append(toDrop, v.Name)
 		}
@@ -107,6 +113,102 @@ func checkIndexDiff(rindexes []*Index, dindexes []options.IndexDescription) (toD
 	return
 }
 
+// prepareIndexDiff diffs indexes declared in the resource before and after the change.
+func prepareIndexDiff(oIndexes, nIndexes []*Index) (toDrop []string, toCreate []*Index) {
+	descriptions := make([]options.IndexDescription, 0, len(oIndexes))
+	oldTypes := make(map[string]string, len(oIndexes))
+	for _, v := range oIndexes {
+		descriptions = append(descriptions, options.IndexDescription{
+			Name:         v.Name,
+			IndexColumns: v.Columns,
+			DataColumns:  v.Cover,
+		})
+		oldTypes[v.Name] = v.Type
+	}
+
+	toDrop, toCreate = checkIndexDiff(nIndexes, descriptions)
+
+	// NOTE: index type is not a part of index description, so indexes
+	// with changed type are recreated here.
+	recreated := make(map[string]struct{}, len(toCreate))
+	for _, v := range toCreate {
+		recreated[v.Name] = struct{}{}
+	}
+	for _, v := range nIndexes {
+		if _, ok := recreated[v.Name]; ok {
+			continue
+		}
+		if typ, ok := oldTypes[v.Name]; ok && typ != v.Type {
+			toDrop = append(toDrop, v.Name)
+			toCreate = append(toCreate, v)
+		}
+	}
+
+	sort.Strings(toDrop)
+	sort.Slice(toCreate, func(i, j int) bool {
+		return toCreate[i].Name < toCreate[j].Name
+	})
+	return toDrop, toCreate
+}
+
+func partitioningSettingsMap(v interface{}) map[string]interface{} {
+	if set, ok := v.(*schema.Set); ok {
+		for _, l := range set.List() {
+			return l.(map[string]interface{})
+		}
+	}
+	return map[string]interface{}{}
+}
+
+// preparePartitioningSettingsDiff returns auto partitioning settings changed in place and
+// settings reset to their defaults. Zero partition size disables partitioning by size
+// only if it is set explicitly.
+// NOTE: uniform_partitions and partition_at_keys are applied only on creation, their
+// changes are handled in CustomizeDiff.
+func preparePartitioningSettingsDiff(o, n interface{}, sizeConfigured bool) (settings *PartitioningSettings, toReset []string) {
+	oSettings := partitioningSettingsMap(o)
+	nSettings := partitioningSettingsMap(n)
+
+	settings = &PartitioningSettings{}
+	changed := false
+	oByLoad, _ := oSettings["auto_partitioning_by_load"].(bool)
+	nByLoad, _ := nSettings["auto_partitioning_by_load"].(bool)
+	if oByLoad != nByLoad {
+		settings.ByLoad = &nByLoad
+		changed = true
+	}
+	oSize, _ := oSettings["auto_partitioning_partition_size_mb"].(int)
+	nSize, _ := nSettings["auto_partitioning_partition_size_mb"].(int)
+	if oSize != nSize && (nSize != 0 || sizeConfigured) {
+		settings.BySize = &nSize
+		changed = true
+	}
+	oMin, _ := oSettings["auto_partitioning_min_partitions_count"].(int)
+	nMin, _ := nSettings["auto_partitioning_min_partitions_count"].(int)
+	if oMin != nMin {
+		if nMin == 0 {
+			toReset = append(toReset, "AUTO_PARTITIONING_MIN_PARTITIONS_COUNT")
+		} else {
+			settings.MinPartitionsCount = nMin
+			changed = true
+		}
+	}
+	oMax, _ := oSettings["auto_partitioning_max_partitions_count"].(int)
+	nMax, _ := nSettings["auto_partitioning_max_partitions_count"].(int)
+	if oMax != nMax {
+		if nMax == 0 {
+			toReset = append(toReset, "AUTO_PARTITIONING_MAX_PARTITIONS_COUNT")
+		} else {
+			settings.MaxPartitionsCount = nMax
+			changed = true
+		}
+	}
+	if !changed {
+		settings = nil
+	}
+	return settings, toReset
+}
+
 func prepareTableDiff(d *schema.ResourceData) (*tableDiff, error) {
 	diff := &tableDiff{}
 	if d.HasChange("column") {
@@ -119,18 +221,26 @@ func prepareTableDiff(d *schema.ResourceData) (*tableDiff, error) {
 		}
 		diff.ColumnsToAdd = newColumns
 	}
+	if d.HasChange("index") {
+		o, n := d.GetChange("index")
+		diff.IndexesToDrop, diff.IndexesToCreate = prepareIndexDiff(expandIndexes(o), expandIndexes(n))
+	}
 	if d.HasChange("ttl") {
-		diff.NewTTLSettings = expandTableTTLSettings(d)
+		var err error
+		diff.NewTTLSettings, err = expandTableTTLSettings(d)
+		if err != nil {
+			return nil, fmt.Errorf("failed to expand new ttl settings: %w", err)
+		}
 		if diff.NewTTLSettings == nil {
 			diff.OnlyResetTTL = true
 		}
 	}
 	if d.HasChange("partitioning_settings") {
-		var err error
-		diff.NewPartitioningSettings, err = expandTablePartitioningPolicySettings(d, nil, nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to expand new partitioning settings: %w", err)
+		if d.Get("store").(string) == storeTypeColumn {
+			return nil, fmt.Errorf("partitioning settings of column table can not be changed")
 		}
+		o, n := d.GetChange("partitioning_settings")
+		diff.NewPartitioningSettings, diff.PartitioningSettingsToReset = preparePartitioningSettingsDiff(o, n, partitionSizeConfigured(d))
 	}
 	if d.HasChange("key_bloom_filter") {
 		val := false
@@ -140,9 +250,10 @@ func prepareTableDiff(d *schema.ResourceData) (*tableDiff, error) {
 		}
 		diff.NewKeyBloomFilterSettings = &val
 	}
-	if d.HasChange("read_replicas_settings") {
-		if v, ok := d.GetOk("read_replicas_settings"); ok {
-			diff.ReadReplicasSettings = v.(string)
+	if d.HasChange("read_replicas") {
+		diff.NewReadReplicasSettings = expandTableReplicasSettings(d)
+		if diff.NewReadReplicasSettings == nil {
+			diff.ResetReadReplicas = true
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 )
@@ -310,6 +311,25 @@ func TestCheckIndexDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			testName: "keep unchanged indexes",
+			rindexes: []*Index{
+				{
+					Name:    "a",
+					Columns: []string{"aa"},
+					Cover:   []string{"cc"},
+				},
+			},
+			dindexes: []options.IndexDescription{
+				{
+					Name:         "a",
+					IndexColumns: []string{"aa"},
+					DataColumns:  []string{"cc"},
+				},
+			},
+			expectedToDrop:   nil,
+			expectedToCreate: nil,
+		},
 	}
 
 	for _, v := range testData {
@@ -327,3 +347,259 @@ func TestCheckIndexDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestPrepareIndexDiff(t *testing.T) {
+	testData := []struct {
+		testName         string
+		oIndexes         []*Index
+		nIndexes         []*Index
+		expectedToDrop   []string
+		expectedToCreate []*Index
+	}{
+		{
+			testName: "no changes",
+			oIndexes: []*Index{
+				{Name: "a", Type: "global_sync", Columns: []string{"aa"}},
+			},
+			nIndexes: []*Index{
+				{Name: "a", Type: "global_sync", Columns: []string{"aa"}},
+			},
+		},
+		{
+			testName: "index type changed",
+			oIndexes: []*Index{
+				{Name: "a", Type: "global_sync", Columns: []string{"aa"}},
+				{Name: "b", Type: "global_sync", Columns: []string{"bb"}},
+			},
+			nIndexes: []*Index{
+				{Name: "a", Type: "global_async", Columns: []string{"aa"}},
+				{Name: "b", Type: "global_sync", Columns: []string{"bb"}},
+			},
+			expectedToDrop: []string{"a"},
+			expectedToCreate: []*Index{
+				{Name: "a", Type: "global_async", Columns: []string{"aa"}},
+			},
+		},
+		{
+			testName: "index added, changed and removed",
+			oIndexes: []*Index{
+				{Name: "a", Type: "global_sync", Columns: []string{"aa"}},
+				{Name: "b", Type: "global_sync", Columns: []string{"bb"}},
+			},
+			nIndexes: []*Index{
+				{Name: "b", Type: "global_async", Columns: []string{"bb", "cc"}},
+				{Name: "c", Type: "global_sync", Columns: []string{"cc"}},
+			},
+			expectedToDrop: []string{"a", "b"},
+			expectedToCreate: []*Index{
+				{Name: "b", Type: "global_async", Columns: []string{"bb", "cc"}},
+				{Name: "c", Type: "global_sync", Columns: []string{"cc"}},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			gotToDrop, gotToCreate := prepareIndexDiff(v.oIndexes, v.nIndexes)
+			assert.Equal(t, v.expectedToDrop, gotToDrop)
+			assert.Equal(t, v.expectedToCreate, gotToCreate)
+		})
+	}
+}
+
+func TestPreparePartitioningSettingsDiff(t *testing.T) {
+	partitioningSettings := func(m map[string]interface{}) *schema.Set {
+		return schema.NewSet(func(interface{}) int { return 0 }, []interface{}{m})
+	}
+	byLoad := true
+	bySize := 100
+	bySizeDisabled := 0
+
+	testData := []struct {
+		testName        string
+		o               map[string]interface{}
+		n               map[string]interface{}
+		sizeConfigured  bool
+		expected        *PartitioningSettings
+		expectedToReset []string
+	}{
+		{
+			testName: "no changes",
+			o: map[string]interface{}{
+				"auto_partitioning_partition_size_mb":    2048,
+				"auto_partitioning_min_partitions_count": 1,
+			},
+			n: map[string]interface{}{
+				"auto_partitioning_partition_size_mb":    2048,
+				"auto_partitioning_min_partitions_count": 1,
+			},
+		},
+		{
+			testName: "changed settings",
+			o: map[string]interface{}{
+				"auto_partitioning_by_load":              false,
+				"auto_partitioning_partition_size_mb":    2048,
+				"auto_partitioning_min_partitions_count": 1,
+				"auto_partitioning_max_partitions_count": 50,
+			},
+			n: map[string]interface{}{
+				"auto_partitioning_by_load":              true,
+				"auto_partitioning_partition_size_mb":    100,
+				"auto_partitioning_min_partitions_count": 2,
+				"auto_partitioning_max_partitions_count": 50,
+			},
+			expected: &PartitioningSettings{
+				ByLoad:             &byLoad,
+				BySize:             &bySize,
+				MinPartitionsCount: 2,
+			},
+		},
+		{
+			testName: "disable partitioning by size",
+			o: map[string]interface{}{
+				"auto_partitioning_partition_size_mb": 2048,
+			},
+			n: map[string]interface{}{
+				"auto_partitioning_partition_size_mb": 0,
+			},
+			sizeConfigured: true,
+			expected: &PartitioningSettings{
+				BySize: &bySizeDisabled,
+			},
+		},
+		{
+			testName: "partition size is not configured",
+			o: map[string]interface{}{
+				"auto_partitioning_partition_size_mb": 2048,
+			},
+			n: map[string]interface{}{
+				"auto_partitioning_partition_size_mb": 0,
+			},
+		},
+		{
+			testName: "reset partitions count",
+			o: map[string]interface{}{
+				"auto_partitioning_min_partitions_count": 2,
+				"auto_partitioning_max_partitions_count": 50,
+			},
+			n: map[string]interface{}{
+				"auto_partitioning_min_partitions_count": 0,
+				"auto_partitioning_max_partitions_count": 0,
+			},
+			expectedToReset: []string{
+				"AUTO_PARTITIONING_MIN_PARTITIONS_COUNT",
+				"AUTO_PARTITIONING_MAX_PARTITIONS_COUNT",
+			},
+		},
+		{
+			testName: "uniform partitions and partition at keys are ignored",
+			o: map[string]interface{}{
+				"uniform_partitions": 4,
+			},
+			n: map[string]interface{}{
+				"uniform_partitions": 8,
+				"partition_at_keys": []interface{}{
+					map[string]interface{}{
+						"keys": []interface{}{"a"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			got, toReset := preparePartitioningSettingsDiff(partitioningSettings(v.o), partitioningSettings(v.n), v.sizeConfigured)
+			assert.Equal(t, v.expected, got)
+			assert.Equal(t, v.expectedToReset, toReset)
+		})
+	}
+}
+
+func TestPrepareChangefeedDiff(t *testing.T) {
+	consumers := func(names ...string) *schema.Set {
+		set := schema.NewSet(func(v interface{}) int {
+			return schema.HashString(v.(map[string]interface{})["name"])
+		}, nil)
+		for _, name := range names {
+			set.Add(map[string]interface{}{
+				"name": name,
+			})
+		}
+		return set
+	}
+	cdc := func(name, mode string, consumerNames ...string) interface{} {
+		return map[string]interface{}{
+			"name":               name,
+			"mode":               mode,
+			"format":             "JSON",
+			"virtual_timestamps": false,
+			"retention_period":   "",
+			"consumer":           consumers(consumerNames...),
+		}
+	}
+
+	testData := []struct {
+		testName                 string
+		o                        []interface{}
+		n                        []interface{}
+		expectedToDrop           []string
+		expectedToCreate         []string
+		expectedConsumersToAlter []string
+	}{
+		{
+			testName: "no changes",
+			o:        []interface{}{cdc("a", "UPDATES", "c1")},
+			n:        []interface{}{cdc("a", "UPDATES", "c1")},
+		},
+		{
+			testName:         "add changefeed",
+			o:                nil,
+			n:                []interface{}{cdc("a", "UPDATES")},
+			expectedToCreate: []string{"a"},
+		},
+		{
+			testName:       "drop changefeeds",
+			o:              []interface{}{cdc("b", "UPDATES"), cdc("a", "UPDATES")},
+			n:              nil,
+			expectedToDrop: []string{"a", "b"},
+		},
+		{
+			testName:         "recreate changefeed with changed mode",
+			o:                []interface{}{cdc("a", "UPDATES", "c1")},
+			n:                []interface{}{cdc("a", "NEW_IMAGE", "c1")},
+			expectedToDrop:   []string{"a"},
+			expectedToCreate: []string{"a"},
+		},
+		{
+			testName:                 "alter consumers",
+			o:                        []interface{}{cdc("a", "UPDATES", "c1")},
+			n:                        []interface{}{cdc("a", "UPDATES", "c1", "c2")},
+			expectedConsumersToAlter: []string{"a"},
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			diff := prepareChangefeedDiff("table", v.o, v.n)
+			assert.Equal(t, v.expectedToDrop, diff.ToDrop)
+
+			var toCreate []string
+			for _, c := range diff.ToCreate {
+				assert.Equal(t, "table", c.TablePath)
+				toCreate = append(toCreate, c.Name)
+			}
+			assert.Equal(t, v.expectedToCreate, toCreate)
+
+			var toAlter []string
+			for k := range diff.ConsumersToAlter {
+				toAlter = append(toAlter, k)
+			}
+			sort.Strings(toAlter)
+			assert.Equal(t, v.expectedConsumersToAlter, toAlter)
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package table
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestFlattenPartitionAtKeys(t *testing.T) {
+	columns := []options.Column{
+		{
+			Name: "a",
+			Type: types.Optional(types.TypeUint64),
+		},
+		{
+			Name: "b",
+			Type: types.Optional(types.TypeUTF8),
+		},
+	}
+
+	testData := []struct {
+		testName  string
+		keyRanges []options.KeyRange
+		expected  []interface{}
+	}{
+		{
+			testName: "single partition",
+			keyRanges: []options.KeyRange{
+				{},
+			},
+			expected: []interface{}{},
+		},
+		{
+			testName: "split by first column only",
+			keyRanges: []options.KeyRange{
+				{
+					To: types.TupleValue(
+						types.OptionalValue(types.Uint64Value(10)),
+						types.NullValue(types.TypeUTF8),
+					),
+				},
+				{
+					From: types.TupleValue(
+						types.OptionalValue(types.Uint64Value(10)),
+						types.NullValue(types.TypeUTF8),
+					),
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"keys": []interface{}{"10"},
+				},
+			},
+		},
+		{
+			testName: "split by both columns",
+			keyRanges: []options.KeyRange{
+				{
+					To: types.TupleValue(
+						types.OptionalValue(types.Uint64Value(10)),
+						types.OptionalValue(types.TextValue("x")),
+					),
+				},
+				{
+					From: types.TupleValue(
+						types.OptionalValue(types.Uint64Value(10)),
+						types.OptionalValue(types.TextValue("x")),
+					),
+					To: types.TupleValue(
+						types.OptionalValue(types.Uint64Value(20)),
+						types.NullValue(types.TypeUTF8),
+					),
+				},
+				{
+					From: types.TupleValue(
+						types.OptionalValue(types.Uint64Value(20)),
+						types.NullValue(types.TypeUTF8),
+					),
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"keys": []interface{}{"10", "x"},
+				},
+				map[string]interface{}{
+					"keys": []interface{}{"20"},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			got, err := flattenPartitionAtKeys(options.Description{
+				Columns:    columns,
+				PrimaryKey: []string{"a", "b"},
+				KeyRanges:  v.keyRanges,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, v.expected, got)
+		})
+	}
+}
+
+func TestFlattenTableStats(t *testing.T) {
+	statsSchema := map[string]*schema.Schema{
+		"rows_estimate":     {Type: schema.TypeInt, Computed: true},
+		"store_size_bytes":  {Type: schema.TypeInt, Computed: true},
+		"partitions_count":  {Type: schema.TypeInt, Computed: true},
+		"creation_time":     {Type: schema.TypeString, Computed: true},
+		"modification_time": {Type: schema.TypeString, Computed: true},
+		"partition_stats": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rows_estimate":    {Type: schema.TypeInt, Computed: true},
+					"store_size_bytes": {Type: schema.TypeInt, Computed: true},
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, statsSchema, map[string]interface{}{})
+	err := flattenTableStats(d, &options.TableStats{
+		PartitionStats: []options.PartitionStats{
+			{RowsEstimate: 10, StoreSize: 1024},
+			{RowsEstimate: 32, StoreSize: 2048},
+		},
+		RowsEstimate: 42,
+		StoreSize:    3072,
+		Partitions:   2,
+		CreationTime: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, d.Get("rows_estimate"))
+	assert.Equal(t, 3072, d.Get("store_size_bytes"))
+	assert.Equal(t, 2, d.Get("partitions_count"))
+	assert.Equal(t, "2024-01-02T03:04:05Z", d.Get("creation_time"))
+	assert.Equal(t, "", d.Get("modification_time"))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"rows_estimate": 10, "store_size_bytes": 1024},
+		map[string]interface{}{"rows_estimate": 32, "store_size_bytes": 2048},
+	}, d.Get("partition_stats"))
+}
+
+func TestCurrentPartitionsCount(t *testing.T) {
+	desc := options.Description{
+		KeyRanges: []options.KeyRange{{}, {}},
+	}
+	assert.Equal(t, 2, currentPartitionsCount(desc))
+
+	desc.Stats = &options.TableStats{Partitions: 3}
+	assert.Equal(t, 3, currentPartitionsCount(desc))
+}
+
+func TestFlattenAllIndexes(t *testing.T) {
+	indexes := []options.IndexDescription{
+		{Name: "by_a", IndexColumns: []string{"a"}, DataColumns: []string{"b"}},
+		{Name: "by_b", IndexColumns: []string{"b"}},
+	}
+	got := flattenAllIndexes(indexes, map[string]string{
+		"by_a": indexTypeGlobalSync,
+		"by_b": indexTypeGlobalAsync,
+	})
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"name":    "by_a",
+			"type":    "global_sync",
+			"columns": []interface{}{"a"},
+			"cover":   []interface{}{"b"},
+		},
+		map[string]interface{}{
+			"name":    "by_b",
+			"type":    "global_async",
+			"columns": []interface{}{"b"},
+			"cover":   []interface{}{},
+		},
+	}, got)
+
+	got = flattenAllIndexes(indexes, nil)
+	assert.Equal(t, "", got[0].(map[string]interface{})["type"])
+}
+
+func TestUnwrapType(t *testing.T) {
+	testData := []struct {
+		testName        string
+		typ             types.Type
+		expectedType    string
+		expectedNotNull bool
+	}{
+		{
+			testName:     "optional primitive type",
+			typ:          types.Optional(types.TypeUint64),
+			expectedType: "Uint64",
+		},
+		{
+			testName:        "primitive type",
+			typ:             types.TypeUTF8,
+			expectedType:    "Utf8",
+			expectedNotNull: true,
+		},
+		{
+			testName:     "optional decimal",
+			typ:          types.Optional(types.DecimalType(22, 9)),
+			expectedType: "Decimal(22,9)",
+		},
+		{
+			testName:     "optional type unknown to the parser",
+			typ:          types.Optional(types.List(types.TypeUint64)),
+			expectedType: "List<Uint64>",
+		},
+		{
+			testName:        "type unknown to the parser",
+			typ:             types.List(types.TypeUint64),
+			expectedType:    "List<Uint64>",
+			expectedNotNull: true,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			typ, notNull := unwrapType(v.typ)
+			assert.Equal(t, v.expectedType, typ)
+			assert.Equal(t, v.expectedNotNull, notNull)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package tablecopy
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	copyResource, err := tableCopyResourceSchemaToTableCopyResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entity, err := helpers.ParseYDBEntityID(copyResource.id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: copyResource.SourceEntity.PrepareFullYDBEndpoint(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize table client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	// NOTE: CopyTable returns when copying is completed.
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		return s.CopyTable(ctx, entity.GetFullEntityPath(), copyResource.SourceEntity.GetFullEntityPath())
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  "failed to copy table",
+				Detail:   err.Error(),
+			},
+		}
+	}
+
+	d.SetId(entity.ID())
+
+	return h.Read(ctx, d, meta)
+}
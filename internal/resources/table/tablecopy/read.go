@@ -0,0 +1,52 @@
+package tablecopy
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tableresource "github.com/ydb-platform/terraform-provider-ydb/internal/resources/table"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	copyResource, err := tableCopyResourceSchemaToTableCopyResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: copyResource.Entity.PrepareFullYDBEndpoint(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize table client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	description, err := tableresource.DescribeTable(ctx, db, copyResource.Entity.GetFullEntityPath())
+	if err != nil {
+		if strings.Contains(err.Error(), "SCHEME_ERROR") {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe table %q: %s", copyResource.Entity.GetEntityPath(), err)
+	}
+
+	storeType, err := tableresource.DescribeStoreType(ctx, db, copyResource.Entity.GetFullEntityPath())
+	if err != nil {
+		return diag.Errorf("failed to describe path of table %q: %s", copyResource.Entity.GetEntityPath(), err)
+	}
+
+	return diag.FromErr(tableresource.FlattenComputedTableDescription(ctx, db, d, description, copyResource.Entity, storeType))
+}
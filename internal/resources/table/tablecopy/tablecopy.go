@@ -0,0 +1,61 @@
+package tablecopy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+// Handler is resources.Handler without Update: every argument of table copy forces its recreation.
+type Handler interface {
+	Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics
+	Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics
+	Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics
+}
+
+type handler struct {
+	token string
+}
+
+func NewHandler(token string) Handler {
+	return &handler{
+		token: token,
+	}
+}
+
+type resource struct {
+	Path         string
+	SourceEntity *helpers.YDBEntity
+	Entity       *helpers.YDBEntity
+}
+
+func tableCopyResourceSchemaToTableCopyResource(d *schema.ResourceData) (*resource, error) {
+	var entity *helpers.YDBEntity
+	var err error
+	if d.Id() != "" {
+		entity, err = helpers.ParseYDBEntityID(d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse table copy entity: %w", err)
+		}
+	}
+
+	sourceEntity, err := helpers.ParseYDBEntityID(d.Get("source_table_id").(string))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse source_table_id: %w", err)
+	}
+
+	return &resource{
+		Path:         d.Get("path").(string),
+		SourceEntity: sourceEntity,
+		Entity:       entity,
+	}, nil
+}
+
+// id returns ID of the copy, copy is always created in the database of the source table.
+func (r *resource) id() string {
+	return r.SourceEntity.PrepareFullYDBEndpoint() + "?path=" + r.Path
+}
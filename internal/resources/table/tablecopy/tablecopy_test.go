@@ -0,0 +1,65 @@
+package tablecopy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableCopyID(t *testing.T) {
+	testData := []struct {
+		testName      string
+		sourceTableID string
+		path          string
+		expected      string
+		expectedErr   bool
+	}{
+		{
+			testName:      "copy to the same directory",
+			sourceTableID: "grpc://localhost:2136/?database=/local?path=dir/table",
+			path:          "dir/table_copy",
+			expected:      "grpc://localhost:2136/?database=/local?path=dir/table_copy",
+		},
+		{
+			testName:      "copy to another directory with tls",
+			sourceTableID: "grpcs://ydb.serverless.yandexcloud.net:2135/?database=/ru-central1/b1g/etn?path=table",
+			path:          "backup/table",
+			expected:      "grpcs://ydb.serverless.yandexcloud.net:2135/?database=/ru-central1/b1g/etn?path=backup/table",
+		},
+		{
+			testName:      "invalid source table id",
+			sourceTableID: "localhost:2136",
+			path:          "table",
+			expectedErr:   true,
+		},
+	}
+
+	resourceSchema := map[string]*schema.Schema{
+		"source_table_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"path": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+				"source_table_id": v.sourceTableID,
+				"path":            v.path,
+			})
+			r, err := tableCopyResourceSchemaToTableCopyResource(d)
+			if v.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, v.expected, r.id())
+		})
+	}
+}
@@ -44,22 +44,67 @@ func (h *handler) Update(ctx context.Context, d *schema.ResourceData, cfg interf
 		_ = db.Close(ctx)
 	}()
 
+	if d.HasChange("primary_key") {
+		// NOTE: primary key change without migrate_data_on_replace forces replacement in CustomizeDiff.
+		oldPath, _ := d.GetChange("path")
+		oldColumns, _ := d.GetChange("column")
+		columns := migratedColumns(expandColumns(oldColumns), tableResource.Columns)
+		err = migrateTable(ctx, db, oldPath.(string), columns, tableResource)
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "failed to migrate table data",
+					Detail:   err.Error(),
+				},
+			}
+		}
+		d.SetId(tableResource.getConnectionString() + "?path=" + tableResource.Path)
+		return h.Read(ctx, d, cfg)
+	}
+
+	if d.HasChange("path") {
+		// NOTE: table is moved with its indexes and changefeeds, so it is renamed before other changes.
+		oldPath, newPath := d.GetChange("path")
+		err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			return s.ExecuteSchemeQuery(ctx, prepareRenameTableQuery(oldPath.(string), newPath.(string)))
+		})
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "failed to rename table",
+					Detail:   err.Error(),
+				},
+			}
+		}
+		d.SetId(tableResource.getConnectionString() + "?path=" + newPath.(string))
+	}
+
 	request, err := prepareAlterRequest(tableResource.Path, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	// NOTE(shmel1k@): no query after all checks.
-	if request == "" {
-		return nil
+	if request != "" {
+		err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			err = s.ExecuteSchemeQuery(ctx, request)
+			return err
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
-		err = s.ExecuteSchemeQuery(ctx, request)
-		return err
-	})
-	if err != nil {
-		return diag.FromErr(err)
+	if d.HasChange("changefeed") {
+		o, n := d.GetChange("changefeed")
+		diff := prepareChangefeedDiff(tableResource.Path, o.([]interface{}), n.([]interface{}))
+		err = updateChangefeeds(ctx, db, tableResource.Path, diff)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
-	return nil
+
+	return h.Read(ctx, d, cfg)
 }
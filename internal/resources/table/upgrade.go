@@ -0,0 +1,56 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// UpgradeStateV0 replaces `read_replicas_settings` string like "PER_AZ:1" with `read_replicas` block.
+func UpgradeStateV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	settings, _ := rawState["read_replicas_settings"].(string)
+	delete(rawState, "read_replicas_settings")
+	if settings == "" {
+		return rawState, nil
+	}
+
+	replicas, err := parseReadReplicasSettings(settings)
+	if err != nil {
+		return nil, err
+	}
+	// NOTE: tables without read replicas were read as "PER_AZ:0" before.
+	if replicas.Count == 0 {
+		return rawState, nil
+	}
+	rawState["read_replicas"] = []interface{}{
+		map[string]interface{}{
+			"mode":  replicas.Mode,
+			"count": replicas.Count,
+		},
+	}
+	return rawState, nil
+}
+
+func parseReadReplicasSettings(settings string) (*ReplicationSettings, error) {
+	parts := strings.Split(settings, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("failed to parse read replicas settings %q: expected MODE:COUNT", settings)
+	}
+	mode := strings.ToUpper(strings.TrimSpace(parts[0]))
+	if mode != readReplicasModePerAZ && mode != readReplicasModeAnyAZ {
+		return nil, fmt.Errorf("failed to parse read replicas settings %q: unknown mode %q", settings, parts[0])
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil || count < 0 {
+		return nil, fmt.Errorf("failed to parse read replicas settings %q: invalid count %q", settings, parts[1])
+	}
+	return &ReplicationSettings{
+		Mode:  mode,
+		Count: count,
+	}, nil
+}
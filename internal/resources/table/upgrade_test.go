@@ -0,0 +1,106 @@
+package table
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpgradeStateV0(t *testing.T) {
+	testData := []struct {
+		testName    string
+		state       map[string]interface{}
+		expected    map[string]interface{}
+		expectedErr bool
+	}{
+		{
+			testName: "no read replicas settings",
+			state: map[string]interface{}{
+				"path": "a",
+			},
+			expected: map[string]interface{}{
+				"path": "a",
+			},
+		},
+		{
+			testName: "empty read replicas settings",
+			state: map[string]interface{}{
+				"path":                   "a",
+				"read_replicas_settings": "",
+			},
+			expected: map[string]interface{}{
+				"path": "a",
+			},
+		},
+		{
+			testName: "zero replicas",
+			state: map[string]interface{}{
+				"path":                   "a",
+				"read_replicas_settings": "PER_AZ:0",
+			},
+			expected: map[string]interface{}{
+				"path": "a",
+			},
+		},
+		{
+			testName: "per az replicas",
+			state: map[string]interface{}{
+				"path":                   "a",
+				"read_replicas_settings": "PER_AZ:1",
+			},
+			expected: map[string]interface{}{
+				"path": "a",
+				"read_replicas": []interface{}{
+					map[string]interface{}{
+						"mode":  "PER_AZ",
+						"count": 1,
+					},
+				},
+			},
+		},
+		{
+			testName: "any az replicas in lower case",
+			state: map[string]interface{}{
+				"path":                   "a",
+				"read_replicas_settings": "any_az:3",
+			},
+			expected: map[string]interface{}{
+				"path": "a",
+				"read_replicas": []interface{}{
+					map[string]interface{}{
+						"mode":  "ANY_AZ",
+						"count": 3,
+					},
+				},
+			},
+		},
+		{
+			testName: "unknown mode",
+			state: map[string]interface{}{
+				"read_replicas_settings": "abacaba:1",
+			},
+			expectedErr: true,
+		},
+		{
+			testName: "invalid count",
+			state: map[string]interface{}{
+				"read_replicas_settings": "PER_AZ:abacaba",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			got, err := UpgradeStateV0(context.Background(), v.state, nil)
+			if v.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, v.expected, got)
+		})
+	}
+}
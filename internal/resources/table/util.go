@@ -1,11 +1,15 @@
 package table
 
 import (
-	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers/yqltypes"
 )
 
 func isIntColumn(typ string) bool {
@@ -21,7 +25,7 @@ func isBoolColumn(typ string) bool {
 }
 
 func isFloatColumn(typ string) bool {
-	return typ == "Float" || typ == "Decimal" || typ == "Double"
+	return typ == "Float" || typ == "Double"
 }
 
 func isUTF8Column(typ string) bool {
@@ -29,26 +33,19 @@ func isUTF8Column(typ string) bool {
 }
 
 func isStringColumn(typ string) bool {
-	return typ == "String" || typ == "Bytes" || typ == "Optional<String>"
+	return typ == "String"
 }
 
-func parsePartitionKey(k string, typ string) (interface{}, error) {
-	if isIntColumn(typ) {
-		return strconv.ParseInt(k, 10, 64)
-	}
-	if isUintColumn(typ) {
-		return strconv.ParseUint(k, 10, 64)
-	}
-	if isFloatColumn(typ) {
-		return strconv.ParseFloat(k, 64)
-	}
-	if isStringColumn(typ) || isUTF8Column(typ) {
-		return k, nil
-	}
-	if isBoolColumn(typ) {
-		return strconv.ParseBool(k)
-	}
-	return nil, fmt.Errorf("unknown column type %q", typ)
+func isDateColumn(typ string) bool {
+	return typ == "Date"
+}
+
+func isDatetimeColumn(typ string) bool {
+	return typ == "Datetime" || typ == "Timestamp"
+}
+
+func isNullValue(v types.Value) bool {
+	return strings.HasPrefix(v.Yql(), "Nothing(")
 }
 
 func expandColumns(cols interface{}) []*Column {
@@ -60,9 +57,13 @@ func expandColumns(cols interface{}) []*Column {
 		if f, ok := mp["family"].(string); ok {
 			family = f
 		}
+		typ := mp["type"].(string)
+		if normalized, err := yqltypes.NormalizeColumnType(typ); err == nil {
+			typ = normalized
+		}
 		col := &Column{
 			Name:   mp["name"].(string),
-			Type:   mp["type"].(string),
+			Type:   typ,
 			Family: family,
 		}
 		if notNull, ok := mp["not_null"]; ok {
@@ -74,6 +75,39 @@ func expandColumns(cols interface{}) []*Column {
 	return columns
 }
 
+func expandIndexes(v interface{}) []*Index {
+	indexesRaw, ok := v.(*schema.Set)
+	if !ok || indexesRaw == nil {
+		return nil
+	}
+	indexes := make([]*Index, 0, indexesRaw.Len())
+	for _, raw := range indexesRaw.List() {
+		mp := raw.(map[string]interface{})
+		idx := &Index{
+			Name: mp["name"].(string),
+			Type: indexTypeGlobalSync,
+		}
+		if typ, ok := mp["type"].(string); ok && typ != "" {
+			idx.Type = typ
+		}
+		if cols, ok := mp["columns"].([]interface{}); ok {
+			for _, c := range cols {
+				idx.Columns = append(idx.Columns, c.(string))
+			}
+		}
+		if cover, ok := mp["cover"].([]interface{}); ok {
+			for _, c := range cover {
+				idx.Cover = append(idx.Cover, c.(string))
+			}
+		}
+		indexes = append(indexes, idx)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i].Name < indexes[j].Name
+	})
+	return indexes
+}
+
 func expandPrimaryKey(d *schema.ResourceData) []string {
 	pkRaw := d.Get("primary_key").([]interface{})
 	pk := make([]string, 0, len(pkRaw))
@@ -83,6 +117,15 @@ func expandPrimaryKey(d *schema.ResourceData) []string {
 	return pk
 }
 
+func expandPartitionByHash(d *schema.ResourceData) []string {
+	raw := d.Get("partition_by_hash").([]interface{})
+	columns := make([]string, 0, len(raw))
+	for _, v := range raw {
+		columns = append(columns, v.(string))
+	}
+	return columns
+}
+
 func expandColumnFamilies(d *schema.ResourceData) []*Family {
 	familiesRaw := d.Get("family")
 	if familiesRaw == nil {
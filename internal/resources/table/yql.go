@@ -44,7 +44,17 @@ func PrepareCreateRequest(r *Resource) string { //nolint:gocyclo
 	}
 	req[len(req)-1] = ')'
 	req = append(req, '\n')
-	if len(r.Family) > 0 {
+	for _, v := range r.Indexes {
+		if r.isColumnTable() {
+			break
+		}
+		req[len(req)-1] = ','
+		req = append(req, '\n')
+		req = appendIndent(req, indent)
+		req = append(req, v.ToYQL()...)
+		req = append(req, '\n')
+	}
+	if len(r.Family) > 0 && !r.isColumnTable() {
 		req[len(req)-1] = ','
 		for _, v := range r.Family {
 			req = append(req, '\n')
@@ -81,7 +91,20 @@ func PrepareCreateRequest(r *Resource) string { //nolint:gocyclo
 	req = append(req, '\n')
 	indent--
 
-	needWith := false
+	if r.isColumnTable() && len(r.PartitionByHash) > 0 {
+		req = append(req, "PARTITION BY HASH("...)
+		for i, v := range r.PartitionByHash {
+			req = append(req, '`')
+			req = helpers.AppendWithEscape(req, v)
+			req = append(req, '`')
+			if i != len(r.PartitionByHash)-1 {
+				req = append(req, ',', ' ')
+			}
+		}
+		req = append(req, ')', '\n')
+	}
+
+	needWith := r.isColumnTable()
 	if r.TTL != nil {
 		needWith = true
 	}
@@ -103,16 +126,17 @@ func PrepareCreateRequest(r *Resource) string { //nolint:gocyclo
 	req = append(req, ' ', '(', '\n')
 	indent++
 	needComma := false
+	if r.isColumnTable() {
+		req = appendIndent(req, indent)
+		req = append(req, "STORE = COLUMN"...)
+		needComma = true
+	}
 	if r.TTL != nil {
+		if needComma {
+			req = append(req, ',', '\n')
+		}
 		req = appendIndent(req, indent)
-		req = append(req, "TTL = Interval(\""...)
-		req = helpers.AppendWithEscape(req, r.TTL.ExpireInterval)
-		req = append(req, '"')
-		req = append(req, ')')
-		req = append(req, " ON "...)
-		req = append(req, '`')
-		req = helpers.AppendWithEscape(req, r.TTL.ColumnName)
-		req = append(req, '`')
+		req = append(req, r.TTL.ToYQL()...)
 		needComma = true
 	}
 	if r.PartitioningSettings != nil { //nolint:nestif
@@ -133,12 +157,16 @@ func PrepareCreateRequest(r *Resource) string { //nolint:gocyclo
 				req = append(req, ',', '\n')
 			}
 			req = appendIndent(req, indent)
-			req = append(req, "AUTO_PARTITIONING_BY_SIZE = ENABLED"...)
-			req = append(req, ',')
-			req = append(req, '\n')
-			req = appendIndent(req, indent)
-			req = append(req, "AUTO_PARTITIONING_PARTITION_SIZE_MB = "...)
-			req = strconv.AppendInt(req, int64(*r.PartitioningSettings.BySize), 10)
+			if *r.PartitioningSettings.BySize == 0 {
+				req = append(req, "AUTO_PARTITIONING_BY_SIZE = DISABLED"...)
+			} else {
+				req = append(req, "AUTO_PARTITIONING_BY_SIZE = ENABLED"...)
+				req = append(req, ',')
+				req = append(req, '\n')
+				req = appendIndent(req, indent)
+				req = append(req, "AUTO_PARTITIONING_PARTITION_SIZE_MB = "...)
+				req = strconv.AppendInt(req, int64(*r.PartitioningSettings.BySize), 10)
+			}
 			needComma = true
 		}
 		if r.PartitioningSettings.PartitionsCount != 0 {
@@ -162,18 +190,7 @@ func PrepareCreateRequest(r *Resource) string { //nolint:gocyclo
 			for i, v := range r.PartitioningSettings.PartitionAtKeys {
 				req = append(req, '(')
 				for ii, vv := range v.Keys {
-					switch t := vv.(type) {
-					case uint64:
-						req = strconv.AppendUint(req, t, 10)
-					case int64:
-						req = strconv.AppendInt(req, t, 10)
-					case bool:
-						req = strconv.AppendBool(req, t)
-					case string:
-						req = append(req, '"')
-						req = helpers.AppendWithEscape(req, t)
-						req = append(req, '"')
-					}
+					req = append(req, vv.ToYQL()...)
 					if ii < len(v.Keys)-1 {
 						req = append(req, ',')
 					}
@@ -207,14 +224,12 @@ func PrepareCreateRequest(r *Resource) string { //nolint:gocyclo
 			needComma = true
 		}
 	}
-	if r.ReplicationSettings != nil && r.ReplicationSettings.ReadReplicasSettings != "" {
+	if r.ReplicationSettings != nil {
 		if needComma {
 			req = append(req, ',', '\n')
 		}
 		req = appendIndent(req, indent)
-		req = append(req, "READ_REPLICAS_SETTINGS = \""...)
-		req = helpers.AppendWithEscape(req, r.ReplicationSettings.ReadReplicasSettings)
-		req = append(req, '"')
+		req = append(req, r.ReplicationSettings.ToYQL()...)
 		needComma = true
 	}
 	if r.EnableBloomFilter != nil {
@@ -236,11 +251,6 @@ func PrepareCreateRequest(r *Resource) string { //nolint:gocyclo
 
 	req = append(req, '\n', ')')
 
-	//	if len(r.ChangeFeeds) > 0 {
-	//		req = append(req, ';', '\n')
-	//		req = append(req, prepareCDCAlterQuery(r.Path, r.ChangeFeeds)...)
-	//	}
-
 	return string(req)
 }
 
@@ -276,6 +286,27 @@ func prepareDropColumnsQuery(tableName string, columnsToDrop []string) string {
 	return string(req)
 }
 
+func prepareAddIndexQuery(tableName string, index *Index) string {
+	req := make([]byte, 0, 128)
+	req = append(req, "ALTER TABLE `"...)
+	req = helpers.AppendWithEscape(req, tableName)
+	req = append(req, '`', ' ')
+	req = append(req, "ADD "...)
+	req = append(req, index.ToYQL()...)
+	return string(req)
+}
+
+func prepareDropIndexQuery(tableName string, indexName string) string {
+	req := make([]byte, 0, 64)
+	req = append(req, "ALTER TABLE `"...)
+	req = helpers.AppendWithEscape(req, tableName)
+	req = append(req, '`', ' ')
+	req = append(req, "DROP INDEX `"...)
+	req = helpers.AppendWithEscape(req, indexName)
+	req = append(req, '`')
+	return string(req)
+}
+
 func prepareResetTTLQuery(tableName string) string {
 	buf := make([]byte, 0, 64)
 	buf = append(buf, "ALTER TABLE `"...)
@@ -285,6 +316,22 @@ func prepareResetTTLQuery(tableName string) string {
 	return string(buf)
 }
 
+func prepareResetSettingsQuery(tableName string, settings []string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "ALTER TABLE `"...)
+	buf = helpers.AppendWithEscape(buf, tableName)
+	buf = append(buf, '`', ' ')
+	buf = append(buf, "RESET ("...)
+	for i, v := range settings {
+		if i > 0 {
+			buf = append(buf, ',', ' ')
+		}
+		buf = append(buf, v...)
+	}
+	buf = append(buf, ')')
+	return string(buf)
+}
+
 func prepareSetNewTTLSettingsQuery(tableName string, settings *TTL) string {
 	buf := make([]byte, 0, 64)
 	buf = append(buf, "ALTER TABLE `"...)
@@ -315,7 +362,7 @@ func prepareKeyBloomFilterQuery(tableName string, enabled bool) string {
 func prepareNewPartitioningSettingsQuery(
 	tableName string,
 	settings *PartitioningSettings,
-	readReplicaSettings string,
+	readReplicasSettings *ReplicationSettings,
 ) string {
 	buf := make([]byte, 0, 64)
 	buf = append(buf, "ALTER TABLE `"...)
@@ -340,10 +387,14 @@ func prepareNewPartitioningSettingsQuery(
 			buf = append(buf, ',', '\n')
 		}
 		needComma = true
-		buf = append(buf, "AUTO_PARTITIONING_BY_SIZE = ENABLED"...)
-		buf = append(buf, ',', '\n')
-		buf = append(buf, "AUTO_PARTITIONING_PARTITION_SIZE_MB = "...)
-		buf = strconv.AppendInt(buf, int64(*settings.BySize), 10)
+		if *settings.BySize == 0 {
+			buf = append(buf, "AUTO_PARTITIONING_BY_SIZE = DISABLED"...)
+		} else {
+			buf = append(buf, "AUTO_PARTITIONING_BY_SIZE = ENABLED"...)
+			buf = append(buf, ',', '\n')
+			buf = append(buf, "AUTO_PARTITIONING_PARTITION_SIZE_MB = "...)
+			buf = strconv.AppendInt(buf, int64(*settings.BySize), 10)
+		}
 	}
 	if settings != nil && settings.MinPartitionsCount != 0 {
 		if needComma {
@@ -359,14 +410,13 @@ func prepareNewPartitioningSettingsQuery(
 		}
 		buf = append(buf, "AUTO_PARTITIONING_MAX_PARTITIONS_COUNT = "...)
 		buf = strconv.AppendInt(buf, int64(settings.MaxPartitionsCount), 10)
+		needComma = true
 	}
-	if readReplicaSettings != "" {
+	if readReplicasSettings != nil {
 		if needComma {
 			buf = append(buf, ',', '\n')
 		}
-		buf = append(buf, "READ_REPLICAS_SETTINGS = \""...)
-		buf = helpers.AppendWithEscape(buf, readReplicaSettings)
-		buf = append(buf, '"')
+		buf = append(buf, readReplicasSettings.ToYQL()...)
 	}
 	buf = append(buf, '\n', ')')
 
@@ -380,6 +430,13 @@ func PrepareAlterRequest(diff *tableDiff) string {
 
 	req := make([]byte, 0, defaultRequestCapacity)
 	needSemiColon := false
+	for _, v := range diff.IndexesToDrop {
+		if needSemiColon {
+			req = append(req, ';', '\n')
+		}
+		needSemiColon = true
+		req = append(req, prepareDropIndexQuery(diff.TableName, v)...)
+	}
 	if len(diff.ColumnsToAdd) > 0 {
 		if needSemiColon {
 			req = append(req, ';', '\n')
@@ -397,14 +454,28 @@ func PrepareAlterRequest(diff *tableDiff) string {
 		req = append(req, prepareSetNewTTLSettingsQuery(diff.TableName, diff.NewTTLSettings)...)
 	}
 	if diff.OnlyResetTTL {
+		if needSemiColon {
+			req = append(req, ';', '\n')
+		}
 		needSemiColon = true
 		req = append(req, prepareResetTTLQuery(diff.TableName)...)
 	}
-	if diff.NewPartitioningSettings != nil || diff.ReadReplicasSettings != "" {
+	if diff.NewPartitioningSettings != nil || diff.NewReadReplicasSettings != nil {
+		if needSemiColon {
+			req = append(req, ';', '\n')
+		}
+		req = append(req, prepareNewPartitioningSettingsQuery(diff.TableName, diff.NewPartitioningSettings, diff.NewReadReplicasSettings)...)
+		needSemiColon = true
+	}
+	settingsToReset := diff.PartitioningSettingsToReset
+	if diff.ResetReadReplicas {
+		settingsToReset = append(settingsToReset, "READ_REPLICAS_SETTINGS")
+	}
+	if len(settingsToReset) > 0 {
 		if needSemiColon {
 			req = append(req, ';', '\n')
 		}
-		req = append(req, prepareNewPartitioningSettingsQuery(diff.TableName, diff.NewPartitioningSettings, diff.ReadReplicasSettings)...)
+		req = append(req, prepareResetSettingsQuery(diff.TableName, settingsToReset)...)
 		needSemiColon = true
 	}
 
@@ -416,11 +487,30 @@ func PrepareAlterRequest(diff *tableDiff) string {
 		needSemiColon = true
 	}
 
+	for _, v := range diff.IndexesToCreate {
+		if needSemiColon {
+			req = append(req, ';', '\n')
+		}
+		needSemiColon = true
+		req = append(req, prepareAddIndexQuery(diff.TableName, v)...)
+	}
+
 	_ = needSemiColon
 
 	return string(req)
 }
 
+func prepareRenameTableQuery(tableName string, newTableName string) string {
+	buf := make([]byte, 0, 128)
+	buf = append(buf, "ALTER TABLE `"...)
+	buf = helpers.AppendWithEscape(buf, tableName)
+	buf = append(buf, '`', ' ')
+	buf = append(buf, "RENAME TO `"...)
+	buf = helpers.AppendWithEscape(buf, newTableName)
+	buf = append(buf, '`')
+	return string(buf)
+}
+
 func PrepareDropTableRequest(tableName string) string {
 	buf := make([]byte, 0, 64)
 	buf = append(buf, "DROP TABLE `"...)
@@ -138,6 +138,106 @@ func TestPrepareCreateRequest(t *testing.T) {
 				"\t)" + "\n" +
 				")\n",
 		},
+		{
+			testName: "table with indexes and column family",
+			resource: &Resource{
+				FullPath: "hello/world",
+				Columns: []*Column{
+					{
+						Name: "a",
+						Type: "Uint64",
+					},
+					{
+						Name: "b",
+						Type: "Utf8",
+					},
+					{
+						Name: "c",
+						Type: "Utf8",
+					},
+				},
+				PrimaryKey: &PrimaryKey{
+					Columns: []string{
+						"a",
+					},
+				},
+				Indexes: []*Index{
+					{
+						Name:    "by_b",
+						Type:    "global_sync",
+						Columns: []string{"b"},
+					},
+					{
+						Name:    "by_b_c",
+						Type:    "global_async",
+						Columns: []string{"b", "c"},
+						Cover:   []string{"a"},
+					},
+				},
+				Family: []*Family{
+					{
+						Name:        "some_family",
+						Data:        "ssd",
+						Compression: "lz4",
+					},
+				},
+			},
+			expected: "CREATE TABLE `hello\\/world`(" + "\n" +
+				"\t`a` Uint64," + "\n" +
+				"\t`b` Utf8," + "\n" +
+				"\t`c` Utf8," + "\n" +
+				"\tPRIMARY KEY (`a`)," + "\n" +
+				"\tINDEX `by_b` GLOBAL SYNC ON (`b`)," + "\n" +
+				"\tINDEX `by_b_c` GLOBAL ASYNC ON (`b`, `c`) COVER (`a`)," + "\n" +
+				"\tFAMILY `some_family`(" + "\n" +
+				"\t\tDATA = \"ssd\"," + "\n" +
+				"\t\tCOMPRESSION = \"lz4\"" + "\n" +
+				"\t)" + "\n" +
+				")\n",
+		},
+		{
+			testName: "column table with ttl",
+			resource: &Resource{
+				FullPath:  "hello/world",
+				StoreType: "column",
+				Columns: []*Column{
+					{
+						Name:    "a",
+						Type:    "Uint64",
+						NotNull: true,
+					},
+					{
+						Name:    "ts",
+						Type:    "Timestamp",
+						NotNull: true,
+					},
+				},
+				PrimaryKey: &PrimaryKey{
+					Columns: []string{
+						"a", "ts",
+					},
+				},
+				PartitionByHash: []string{"a", "ts"},
+				TTL: &TTL{
+					ColumnName:     "ts",
+					ExpireInterval: "P7D",
+				},
+				PartitioningSettings: &PartitioningSettings{
+					MinPartitionsCount: 10,
+				},
+			},
+			expected: "CREATE TABLE `hello\\/world`(" + "\n" +
+				"\t`a` Uint64 NOT NULL," + "\n" +
+				"\t`ts` Timestamp NOT NULL," + "\n" +
+				"\tPRIMARY KEY (`a`,`ts`)" + "\n" +
+				")" + "\n" +
+				"PARTITION BY HASH(`a`, `ts`)" + "\n" +
+				"WITH (" + "\n" +
+				"\tSTORE = COLUMN," + "\n" +
+				"\tTTL = Interval(\"P7D\") ON `ts`," + "\n" +
+				"\tAUTO_PARTITIONING_MIN_PARTITIONS_COUNT = 10" + "\n" +
+				")",
+		},
 		{
 			testName: "table with two columns with one as ttl",
 			resource: &Resource{
@@ -171,6 +271,47 @@ func TestPrepareCreateRequest(t *testing.T) {
 				"\tTTL = Interval(\"PT0S\") ON `ttl`" + "\n" +
 				")",
 		},
+		{
+			testName: "table with tiered ttl",
+			resource: &Resource{
+				FullPath: "hello/world",
+				Columns: []*Column{
+					{
+						Name: "mir",
+						Type: "Utf8",
+					},
+					{
+						Name: "ttl",
+						Type: "Timestamp",
+					},
+				},
+				PrimaryKey: &PrimaryKey{
+					Columns: []string{
+						"mir",
+					},
+				},
+				TTL: &TTL{
+					ColumnName: "ttl",
+					Tiers: []*TTLTier{
+						{
+							ExpireInterval:     "P1D",
+							ExternalDataSource: "/local/s3",
+						},
+						{
+							ExpireInterval: "P7D",
+						},
+					},
+				},
+			},
+			expected: "CREATE TABLE `hello\\/world`(" + "\n" +
+				"\t`mir` Utf8," + "\n" +
+				"\t`ttl` Timestamp," + "\n" +
+				"\tPRIMARY KEY (`mir`)" + "\n" +
+				")" + "\n" +
+				"WITH (" + "\n" +
+				"\tTTL = Interval(\"P1D\") TO EXTERNAL DATA SOURCE `\\/local\\/s3`, Interval(\"P7D\") DELETE ON `ttl`" + "\n" +
+				")",
+		},
 		{
 			testName: "table with two columns and partitioning settings",
 			resource: &Resource{
@@ -208,6 +349,50 @@ func TestPrepareCreateRequest(t *testing.T) {
 				"\tAUTO_PARTITIONING_MAX_PARTITIONS_COUNT = 42" + "\n" +
 				")",
 		},
+		{
+			testName: "table with typed partition keys",
+			resource: &Resource{
+				FullPath: "hello/world",
+				Columns: []*Column{
+					{
+						Name: "day",
+						Type: "Date",
+					},
+					{
+						Name: "id",
+						Type: "Uuid",
+					},
+				},
+				PrimaryKey: &PrimaryKey{
+					Columns: []string{
+						"day", "id",
+					},
+				},
+				PartitioningSettings: &PartitioningSettings{
+					PartitionAtKeys: []*PartitionAtKeys{
+						{
+							Keys: []*Literal{
+								{Type: "Date", Value: "2024-01-01"},
+							},
+						},
+						{
+							Keys: []*Literal{
+								{Type: "Date", Value: "2024-02-01"},
+								{Type: "Uuid", Value: "6f9619ff-8b86-d011-b42d-00cf4fc964ff"},
+							},
+						},
+					},
+				},
+			},
+			expected: "CREATE TABLE `hello\\/world`(" + "\n" +
+				"\t`day` Date," + "\n" +
+				"\t`id` Uuid," + "\n" +
+				"\tPRIMARY KEY (`day`,`id`)" + "\n" +
+				")" + "\n" +
+				"WITH (" + "\n" +
+				"\tPARTITION_AT_KEYS = ((Date(\"2024-01-01\")),(Date(\"2024-02-01\"),Uuid(\"6f9619ff-8b86-d011-b42d-00cf4fc964ff\")))" + "\n" +
+				")",
+		},
 		{
 			testName: "table with replica settings",
 			resource: &Resource{
@@ -229,7 +414,8 @@ func TestPrepareCreateRequest(t *testing.T) {
 					},
 				},
 				ReplicationSettings: &ReplicationSettings{
-					ReadReplicasSettings: "PER_AZ",
+					Mode:  "PER_AZ",
+					Count: 1,
 				},
 			},
 			expected: "CREATE TABLE `hello\\/world`(" + "\n" +
@@ -238,7 +424,7 @@ func TestPrepareCreateRequest(t *testing.T) {
 				"\tPRIMARY KEY (`mir`)" + "\n" +
 				")" + "\n" +
 				"WITH (" + "\n" +
-				"\tREAD_REPLICAS_SETTINGS = \"PER_AZ\"" + "\n" +
+				"\tREAD_REPLICAS_SETTINGS = \"PER_AZ:1\"" + "\n" +
 				")",
 		},
 	}
@@ -395,6 +581,25 @@ func TestPrepareSetNewTTLSettingsQuery(t *testing.T) {
 			},
 			expected: "ALTER TABLE `table` SET (TTL = Interval(\"Never\") ON `abacaba`)",
 		},
+		{
+			testName:  "tiered ttl",
+			tableName: "table",
+			ttlSettings: &TTL{
+				ColumnName: "abacaba",
+				Tiers: []*TTLTier{
+					{
+						ExpireInterval:     "PT1H",
+						ExternalDataSource: "cold",
+					},
+					{
+						ExpireInterval:     "P1D",
+						ExternalDataSource: "colder",
+					},
+				},
+			},
+			expected: "ALTER TABLE `table` SET (TTL = Interval(\"PT1H\") TO EXTERNAL DATA SOURCE `cold`, " +
+				"Interval(\"P1D\") TO EXTERNAL DATA SOURCE `colder` ON `abacaba`)",
+		},
 	}
 
 	for _, v := range testData {
@@ -408,6 +613,7 @@ func TestPrepareSetNewTTLSettingsQuery(t *testing.T) {
 
 func TestPrepareNewPartitioningSettingsQuery(t *testing.T) {
 	partitioningBySize := 42
+	partitioningBySizeDisabled := 0
 	partitioningByLoadFalse := false
 	partitioningByLoadTrue := true
 
@@ -415,15 +621,15 @@ func TestPrepareNewPartitioningSettingsQuery(t *testing.T) {
 		testName            string
 		tableName           string
 		settings            *PartitioningSettings
-		readReplicaSettings string
+		readReplicaSettings *ReplicationSettings
 		expected            string
 	}{
 		{
 			testName:            "only read_replica_settings are changed",
 			tableName:           "abacaba",
-			readReplicaSettings: "abacaba",
+			readReplicaSettings: &ReplicationSettings{Mode: "PER_AZ", Count: 2},
 			expected: "ALTER TABLE `abacaba` SET (\n" +
-				"READ_REPLICAS_SETTINGS = \"abacaba\"\n)",
+				"READ_REPLICAS_SETTINGS = \"PER_AZ:2\"\n)",
 		},
 		{
 			testName:  "enable only partitioning_by_size",
@@ -435,6 +641,26 @@ func TestPrepareNewPartitioningSettingsQuery(t *testing.T) {
 				"AUTO_PARTITIONING_BY_SIZE = ENABLED,\n" +
 				"AUTO_PARTITIONING_PARTITION_SIZE_MB = 42\n)",
 		},
+		{
+			testName:  "disable partitioning_by_size",
+			tableName: "abacaba",
+			settings: &PartitioningSettings{
+				BySize: &partitioningBySizeDisabled,
+			},
+			expected: "ALTER TABLE `abacaba` SET (\n" +
+				"AUTO_PARTITIONING_BY_SIZE = DISABLED\n)",
+		},
+		{
+			testName:  "max partitions count with read replicas",
+			tableName: "abacaba",
+			settings: &PartitioningSettings{
+				MaxPartitionsCount: 8,
+			},
+			readReplicaSettings: &ReplicationSettings{Mode: "ANY_AZ", Count: 1},
+			expected: "ALTER TABLE `abacaba` SET (\n" +
+				"AUTO_PARTITIONING_MAX_PARTITIONS_COUNT = 8,\n" +
+				"READ_REPLICAS_SETTINGS = \"ANY_AZ:1\"\n)",
+		},
 		{
 			testName:  "enable only partitioning_by_load",
 			tableName: "abacaba",
@@ -482,14 +708,14 @@ func TestPrepareNewPartitioningSettingsQuery(t *testing.T) {
 				MinPartitionsCount: 4,
 				MaxPartitionsCount: 42,
 			},
-			readReplicaSettings: "abacaba",
+			readReplicaSettings: &ReplicationSettings{Mode: "PER_AZ", Count: 2},
 			expected: "ALTER TABLE `abacaba` SET (\n" +
 				"AUTO_PARTITIONING_BY_LOAD = ENABLED,\n" +
 				"AUTO_PARTITIONING_BY_SIZE = ENABLED,\n" +
 				"AUTO_PARTITIONING_PARTITION_SIZE_MB = 42,\n" +
 				"AUTO_PARTITIONING_MIN_PARTITIONS_COUNT = 4,\n" +
 				"AUTO_PARTITIONING_MAX_PARTITIONS_COUNT = 42,\n" +
-				"READ_REPLICAS_SETTINGS = \"abacaba\"\n)",
+				"READ_REPLICAS_SETTINGS = \"PER_AZ:2\"\n)",
 		},
 	}
 
@@ -553,13 +779,30 @@ func TestPrepareAlterRequest(t *testing.T) {
 				"AUTO_PARTITIONING_MAX_PARTITIONS_COUNT = 5\n)",
 		},
 		{
-			testName: "change only read_replicas_settings",
+			testName: "change only read_replicas",
 			diff: &tableDiff{
-				TableName:            "abacaba",
-				ReadReplicasSettings: "abacaba",
+				TableName:               "abacaba",
+				NewReadReplicasSettings: &ReplicationSettings{Mode: "ANY_AZ", Count: 1},
 			},
 			expected: "ALTER TABLE `abacaba` SET (\n" +
-				"READ_REPLICAS_SETTINGS = \"abacaba\"\n)",
+				"READ_REPLICAS_SETTINGS = \"ANY_AZ:1\"\n)",
+		},
+		{
+			testName: "reset read_replicas",
+			diff: &tableDiff{
+				TableName:         "abacaba",
+				ResetReadReplicas: true,
+			},
+			expected: "ALTER TABLE `abacaba` RESET (READ_REPLICAS_SETTINGS)",
+		},
+		{
+			testName: "reset partitioning settings and read_replicas",
+			diff: &tableDiff{
+				TableName:                   "abacaba",
+				PartitioningSettingsToReset: []string{"AUTO_PARTITIONING_MIN_PARTITIONS_COUNT"},
+				ResetReadReplicas:           true,
+			},
+			expected: "ALTER TABLE `abacaba` RESET (AUTO_PARTITIONING_MIN_PARTITIONS_COUNT, READ_REPLICAS_SETTINGS)",
 		},
 		{
 			testName: "change only ttl settings",
@@ -573,6 +816,25 @@ func TestPrepareAlterRequest(t *testing.T) {
 			expected: "ALTER TABLE `abacaba` RESET (TTL);\n" +
 				"ALTER TABLE `abacaba` SET (TTL = Interval(\"PT0S\") ON `d`)",
 		},
+		{
+			testName: "recreate and drop indexes with reset ttl",
+			diff: &tableDiff{
+				TableName:     "abacaba",
+				IndexesToDrop: []string{"a", "b"},
+				IndexesToCreate: []*Index{
+					{
+						Name:    "a",
+						Type:    "global_sync",
+						Columns: []string{"c"},
+					},
+				},
+				OnlyResetTTL: true,
+			},
+			expected: "ALTER TABLE `abacaba` DROP INDEX `a`;\n" +
+				"ALTER TABLE `abacaba` DROP INDEX `b`;\n" +
+				"ALTER TABLE `abacaba` RESET (TTL);\n" +
+				"ALTER TABLE `abacaba` ADD INDEX `a` GLOBAL SYNC ON (`c`)",
+		},
 		{
 			testName: "change all settings",
 			diff: &tableDiff{
@@ -601,7 +863,7 @@ func TestPrepareAlterRequest(t *testing.T) {
 					MinPartitionsCount: 4,
 					MaxPartitionsCount: 42,
 				},
-				ReadReplicasSettings: "abacaba",
+				NewReadReplicasSettings: &ReplicationSettings{Mode: "ANY_AZ", Count: 1},
 			},
 			expected: "ALTER TABLE `abacaba` ADD COLUMN `a` Bool FAMILY `my_family` NOT NULL, ADD COLUMN `b` Utf8 FAMILY `my_family` NOT NULL;\n" +
 				"ALTER TABLE `abacaba` RESET (TTL);\n" +
@@ -612,7 +874,7 @@ func TestPrepareAlterRequest(t *testing.T) {
 				"AUTO_PARTITIONING_PARTITION_SIZE_MB = 42,\n" +
 				"AUTO_PARTITIONING_MIN_PARTITIONS_COUNT = 4,\n" +
 				"AUTO_PARTITIONING_MAX_PARTITIONS_COUNT = 42,\n" +
-				"READ_REPLICAS_SETTINGS = \"abacaba\"\n)",
+				"READ_REPLICAS_SETTINGS = \"ANY_AZ:1\"\n)",
 		},
 	}
 
@@ -624,3 +886,33 @@ func TestPrepareAlterRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestPrepareRenameTableQuery(t *testing.T) {
+	testData := []struct {
+		testName     string
+		tableName    string
+		newTableName string
+		expected     string
+	}{
+		{
+			testName:     "rename in the same directory",
+			tableName:    "dir/table",
+			newTableName: "dir/new_table",
+			expected:     "ALTER TABLE `dir\\/table` RENAME TO `dir\\/new_table`",
+		},
+		{
+			testName:     "move to another directory",
+			tableName:    "table",
+			newTableName: "other/dir/table",
+			expected:     "ALTER TABLE `table` RENAME TO `other\\/dir\\/table`",
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			got := prepareRenameTableQuery(v.tableName, v.newTableName)
+			assert.Equal(t, v.expected, got)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userResource, err := userResourceSchemaToUserResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: userResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize table client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	err = executeSchemeQueries(ctx, db, []string{prepareCreateUserQuery(userResource)})
+	if err != nil {
+		// NOTE: query is not added into the error, it contains the password.
+		return diag.Errorf("failed to create user %q: %s", userResource.Name, err)
+	}
+
+	d.SetId(userResource.getConnectionString() + "?path=" + userResource.Name)
+
+	err = executeSchemeQueries(ctx, db, prepareMembershipQueries(userResource.Name, userResource.Groups, nil))
+	if err != nil {
+		return diag.Errorf("failed to add user %q into groups: %s", userResource.Name, err)
+	}
+
+	return h.Read(ctx, d, meta)
+}
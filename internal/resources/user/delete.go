@@ -0,0 +1,35 @@
+package user
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// Delete drops the user, the server removes it from all groups.
+func (h *handler) Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userResource, err := userResourceSchemaToUserResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: userResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Errorf("failed to initialize table client: %s", err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	err = executeSchemeQueries(ctx, db, []string{prepareDropUserQuery(userResource.Name)})
+	if err != nil {
+		return diag.Errorf("failed to drop user %q: %s", userResource.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package user
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userResource, err := userResourceSchemaToUserResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: userResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize table client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	desc, err := describeUser(ctx, db, userResource.Name)
+	if err != nil {
+		return diag.Errorf("failed to read user %q: %s", userResource.Name, err)
+	}
+	if desc == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// NOTE: password can not be read back, it is kept from the state.
+	values := map[string]interface{}{
+		"name":              userResource.Name,
+		"connection_string": userResource.getConnectionString(),
+		"nologin":           !desc.Enabled,
+		"groups":            desc.Groups,
+	}
+	for k, v := range values {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
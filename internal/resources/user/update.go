@@ -0,0 +1,57 @@
+package user
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userResource, err := userResourceSchemaToUserResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: userResource.getConnectionString(),
+		Token:            h.token,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize table client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	if d.HasChange("password") {
+		err = executeSchemeQueries(ctx, db, []string{prepareAlterPasswordQuery(userResource.Name, userResource.Password)})
+		if err != nil {
+			return diag.Errorf("failed to change password of user %q: %s", userResource.Name, err)
+		}
+	}
+	if d.HasChange("nologin") {
+		err = executeSchemeQueries(ctx, db, []string{prepareAlterLoginQuery(userResource.Name, userResource.NoLogin)})
+		if err != nil {
+			return diag.Errorf("failed to change login of user %q: %s", userResource.Name, err)
+		}
+	}
+	if d.HasChange("groups") {
+		o, n := d.GetChange("groups")
+		toAdd, toDrop := diffGroups(expandGroups(o), expandGroups(n))
+		err = executeSchemeQueries(ctx, db, prepareMembershipQueries(userResource.Name, toAdd, toDrop))
+		if err != nil {
+			return diag.Errorf("failed to change groups of user %q: %s", userResource.Name, err)
+		}
+	}
+
+	return h.Read(ctx, d, meta)
+}
@@ -0,0 +1,178 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/group"
+)
+
+type Resource struct {
+	Entity           *helpers.YDBEntity
+	DatabaseEndpoint string
+	Name             string
+	Password         string
+	NoLogin          bool
+	Groups           []string
+}
+
+func (r *Resource) getConnectionString() string {
+	if r.DatabaseEndpoint != "" {
+		return r.DatabaseEndpoint
+	}
+	return r.Entity.PrepareFullYDBEndpoint()
+}
+
+func userResourceSchemaToUserResource(d *schema.ResourceData) (*Resource, error) {
+	var entity *helpers.YDBEntity
+	var err error
+	if d.Id() != "" {
+		entity, err = helpers.ParseYDBEntityID(d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse user entity: %w", err)
+		}
+	}
+
+	r := &Resource{
+		Entity:           entity,
+		DatabaseEndpoint: d.Get("connection_string").(string),
+		Name:             d.Get("name").(string),
+		Password:         d.Get("password").(string),
+		NoLogin:          d.Get("nologin").(bool),
+		Groups:           expandGroups(d.Get("groups")),
+	}
+	if entity != nil {
+		r.Name = entity.GetEntityPath()
+	}
+	return r, nil
+}
+
+func expandGroups(v interface{}) []string {
+	set, ok := v.(*schema.Set)
+	if !ok {
+		return nil
+	}
+	groups := make([]string, 0, set.Len())
+	for _, g := range set.List() {
+		groups = append(groups, g.(string))
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// diffGroups returns groups the user is added into and removed from.
+func diffGroups(oldGroups, newGroups []string) (toAdd, toDrop []string) {
+	oldSet := make(map[string]struct{}, len(oldGroups))
+	for _, v := range oldGroups {
+		oldSet[v] = struct{}{}
+	}
+	newSet := make(map[string]struct{}, len(newGroups))
+	for _, v := range newGroups {
+		newSet[v] = struct{}{}
+		if _, ok := oldSet[v]; !ok {
+			toAdd = append(toAdd, v)
+		}
+	}
+	for _, v := range oldGroups {
+		if _, ok := newSet[v]; !ok {
+			toDrop = append(toDrop, v)
+		}
+	}
+	return toAdd, toDrop
+}
+
+type userDescription struct {
+	Enabled bool
+	Groups  []string
+}
+
+// describeUser reads the user from .sys/auth_users and .sys/auth_group_members system views,
+// nil is returned if the user does not exist.
+func describeUser(ctx context.Context, db ydb.Connection, name string) (*userDescription, error) {
+	var desc *userDescription
+	err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		params := table.NewQueryParameters(
+			table.ValueParam("$sid", types.TextValue(name)),
+		)
+
+		desc = nil
+		res, err := s.StreamExecuteScanQuery(ctx, selectUserQuery, params)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var sid string
+				var enabled bool
+				err = res.ScanNamed(
+					named.OptionalWithDefault("Sid", &sid),
+					named.OptionalWithDefault("IsEnabled", &enabled),
+				)
+				if err != nil {
+					return err
+				}
+				if sid == name {
+					desc = &userDescription{Enabled: enabled}
+				}
+			}
+		}
+		if err = res.Err(); err != nil || desc == nil {
+			return err
+		}
+
+		groups, err := s.StreamExecuteScanQuery(ctx, selectUserGroupsQuery, params)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = groups.Close()
+		}()
+		for groups.NextResultSet(ctx) {
+			for groups.NextRow() {
+				var group string
+				if err = groups.ScanNamed(named.OptionalWithDefault("GroupSid", &group)); err != nil {
+					return err
+				}
+				desc.Groups = append(desc.Groups, group)
+			}
+		}
+		sort.Strings(desc.Groups)
+		return groups.Err()
+	})
+	return desc, err
+}
+
+func executeSchemeQueries(ctx context.Context, db ydb.Connection, queries []string) error {
+	for _, q := range queries {
+		err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			return s.ExecuteSchemeQuery(ctx, q)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prepareMembershipQueries returns queries which add the user into and remove from groups.
+func prepareMembershipQueries(name string, toAdd, toDrop []string) []string {
+	queries := make([]string, 0, len(toAdd)+len(toDrop))
+	for _, v := range toDrop {
+		queries = append(queries, group.PrepareDropUserQuery(v, name))
+	}
+	for _, v := range toAdd {
+		queries = append(queries, group.PrepareAddUserQuery(v, name))
+	}
+	return queries
+}
@@ -0,0 +1,67 @@
+package user
+
+import (
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/group"
+)
+
+func appendPassword(buf []byte, password string) []byte {
+	buf = append(buf, " PASSWORD "...)
+	if password == "" {
+		return append(buf, "NULL"...)
+	}
+	buf = append(buf, '"')
+	buf = helpers.AppendStringWithEscape(buf, password)
+	buf = append(buf, '"')
+	return buf
+}
+
+func appendLogin(buf []byte, nologin bool) []byte {
+	if nologin {
+		return append(buf, " NOLOGIN"...)
+	}
+	return append(buf, " LOGIN"...)
+}
+
+func prepareCreateUserQuery(r *Resource) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "CREATE USER "...)
+	buf = group.AppendRoleName(buf, r.Name)
+	if r.Password != "" {
+		buf = appendPassword(buf, r.Password)
+	}
+	if r.NoLogin {
+		buf = appendLogin(buf, r.NoLogin)
+	}
+	return string(buf)
+}
+
+func prepareAlterPasswordQuery(name, password string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "ALTER USER "...)
+	buf = group.AppendRoleName(buf, name)
+	buf = appendPassword(buf, password)
+	return string(buf)
+}
+
+func prepareAlterLoginQuery(name string, nologin bool) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "ALTER USER "...)
+	buf = group.AppendRoleName(buf, name)
+	buf = appendLogin(buf, nologin)
+	return string(buf)
+}
+
+func prepareDropUserQuery(name string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "DROP USER "...)
+	buf = group.AppendRoleName(buf, name)
+	return string(buf)
+}
+
+const (
+	selectUserQuery = `DECLARE $sid AS Utf8;
+SELECT Sid, IsEnabled FROM ` + "`.sys/auth_users`" + ` WHERE Sid = $sid;`
+	selectUserGroupsQuery = `DECLARE $sid AS Utf8;
+SELECT GroupSid FROM ` + "`.sys/auth_group_members`" + ` WHERE MemberSid = $sid;`
+)
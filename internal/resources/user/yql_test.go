@@ -0,0 +1,72 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrepareUserQueries(t *testing.T) {
+	testData := []struct {
+		testName string
+		query    string
+		expected string
+	}{
+		{
+			testName: "create user without password",
+			query:    prepareCreateUserQuery(&Resource{Name: "robot"}),
+			expected: "CREATE USER `robot`",
+		},
+		{
+			testName: "create user with password and nologin",
+			query: prepareCreateUserQuery(&Resource{
+				Name:     "robot",
+				Password: `p@ss"w\rd`,
+				NoLogin:  true,
+			}),
+			expected: "CREATE USER `robot` PASSWORD \"p@ss\\\"w\\\\rd\" NOLOGIN",
+		},
+		{
+			testName: "change password",
+			query:    prepareAlterPasswordQuery("robot", "secret"),
+			expected: "ALTER USER `robot` PASSWORD \"secret\"",
+		},
+		{
+			testName: "change password with line break",
+			query:    prepareAlterPasswordQuery("robot", "se\ncret"),
+			expected: "ALTER USER `robot` PASSWORD \"se\\ncret\"",
+		},
+		{
+			testName: "reset password",
+			query:    prepareAlterPasswordQuery("robot", ""),
+			expected: "ALTER USER `robot` PASSWORD NULL",
+		},
+		{
+			testName: "allow login",
+			query:    prepareAlterLoginQuery("robot", false),
+			expected: "ALTER USER `robot` LOGIN",
+		},
+		{
+			testName: "drop user",
+			query:    prepareDropUserQuery("robot"),
+			expected: "DROP USER `robot`",
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			assert.Equal(t, v.expected, v.query)
+		})
+	}
+}
+
+func TestPrepareMembershipQueries(t *testing.T) {
+	toAdd, toDrop := diffGroups([]string{"admins", "readers"}, []string{"readers", "writers"})
+	assert.Equal(t, []string{"writers"}, toAdd)
+	assert.Equal(t, []string{"admins"}, toDrop)
+	assert.Equal(t, []string{
+		"ALTER GROUP `admins` DROP USER `robot`",
+		"ALTER GROUP `writers` ADD USER `robot`",
+	}, prepareMembershipQueries("robot", toAdd, toDrop))
+}
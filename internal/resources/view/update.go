@@ -0,0 +1,13 @@
+package view
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// NOTE: only depends_on_tables is updated in place, it is not stored in YDB.
+	return h.Read(ctx, d, meta)
+}
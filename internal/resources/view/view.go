@@ -0,0 +1,51 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+type Resource struct {
+	Entity           *helpers.YDBEntity
+	DatabaseEndpoint string
+	Path             string
+	Query            string
+}
+
+func (r *Resource) getConnectionString() string {
+	if r.DatabaseEndpoint != "" {
+		return r.DatabaseEndpoint
+	}
+	return r.Entity.PrepareFullYDBEndpoint()
+}
+
+func viewResourceSchemaToViewResource(d *schema.ResourceData) (*Resource, error) {
+	var entity *helpers.YDBEntity
+	var err error
+	if d.Id() != "" {
+		entity, err = helpers.ParseYDBEntityID(d.Id())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse view entity: %w", err)
+		}
+	}
+
+	r := &Resource{
+		Entity:           entity,
+		DatabaseEndpoint: d.Get("connection_string").(string),
+		Path:             strings.Trim(d.Get("path").(string), "/"),
+		Query:            d.Get("query").(string),
+	}
+	if entity != nil {
+		r.Path = entity.GetEntityPath()
+	}
+	return r, nil
+}
+
+// NormalizeQuery trims whitespaces and trailing semicolon of the view query.
+func NormalizeQuery(query string) string {
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(query), ";"))
+}
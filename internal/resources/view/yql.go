@@ -0,0 +1,25 @@
+package view
+
+import (
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+func prepareCreateViewQuery(r *Resource) string {
+	buf := make([]byte, 0, 64+len(r.Query))
+	buf = append(buf, "CREATE VIEW "...)
+	buf = append(buf, '`')
+	buf = helpers.AppendWithEscape(buf, r.Path)
+	buf = append(buf, '`')
+	buf = append(buf, " WITH (security_invoker = TRUE) AS\n"...)
+	buf = append(buf, NormalizeQuery(r.Query)...)
+	return string(buf)
+}
+
+func prepareDropViewQuery(viewPath string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "DROP VIEW "...)
+	buf = append(buf, '`')
+	buf = helpers.AppendWithEscape(buf, viewPath)
+	buf = append(buf, '`')
+	return string(buf)
+}
@@ -0,0 +1,45 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrepareCreateViewQuery(t *testing.T) {
+	testData := []struct {
+		testName string
+		resource *Resource
+		expected string
+	}{
+		{
+			testName: "simple query",
+			resource: &Resource{
+				Path:  "bi/active_users",
+				Query: "SELECT * FROM `users` WHERE active",
+			},
+			expected: "CREATE VIEW `bi\\/active_users` WITH (security_invoker = TRUE) AS\n" +
+				"SELECT * FROM `users` WHERE active",
+		},
+		{
+			testName: "query with trailing semicolon and newlines",
+			resource: &Resource{
+				Path:  "active_users",
+				Query: "\nSELECT id\nFROM `users`;\n",
+			},
+			expected: "CREATE VIEW `active_users` WITH (security_invoker = TRUE) AS\n" +
+				"SELECT id\nFROM `users`",
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			assert.Equal(t, v.expected, prepareCreateViewQuery(v.resource))
+		})
+	}
+}
+
+func TestPrepareDropViewQuery(t *testing.T) {
+	assert.Equal(t, "DROP VIEW `active_users`", prepareDropViewQuery("active_users"))
+}
@@ -74,6 +74,7 @@ func ydbTableChangeFeedResource() *schema.Resource {
 		ReadContext:   resourceYDBTableChangefeedRead,
 		UpdateContext: resourceYDBTableChangefeedUpdate,
 		DeleteContext: resourceYDBTableChangefeedDelete,
+		CustomizeDiff: changefeed.ResourceCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
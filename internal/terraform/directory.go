@@ -0,0 +1,60 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/directory"
+)
+
+func ydbDirectoryResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        directory.ResourceSchema(),
+		CreateContext: resourceYDBDirectoryCreate,
+		ReadContext:   resourceYDBDirectoryRead,
+		UpdateContext: resourceYDBDirectoryUpdate,
+		DeleteContext: resourceYDBDirectoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultTimeouts(),
+	}
+}
+
+func resourceYDBDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return directory.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBDirectoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return directory.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBDirectoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return directory.ResourceUpdateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBDirectoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return directory.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
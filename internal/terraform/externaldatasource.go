@@ -0,0 +1,48 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/externaldatasource"
+)
+
+func ydbExternalDataSourceResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        externaldatasource.ResourceSchema(),
+		CreateContext: resourceYDBExternalDataSourceCreate,
+		ReadContext:   resourceYDBExternalDataSourceRead,
+		DeleteContext: resourceYDBExternalDataSourceDelete,
+		CustomizeDiff: externaldatasource.ResourceCustomizeDiff,
+		Timeouts:      defaultTimeouts(),
+	}
+}
+
+func resourceYDBExternalDataSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return externaldatasource.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBExternalDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return externaldatasource.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBExternalDataSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return externaldatasource.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
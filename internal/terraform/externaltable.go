@@ -0,0 +1,47 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/externaltable"
+)
+
+func ydbExternalTableResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        externaltable.ResourceSchema(),
+		CreateContext: resourceYDBExternalTableCreate,
+		ReadContext:   resourceYDBExternalTableRead,
+		DeleteContext: resourceYDBExternalTableDelete,
+		Timeouts:      defaultTimeouts(),
+	}
+}
+
+func resourceYDBExternalTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return externaltable.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBExternalTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return externaltable.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBExternalTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return externaltable.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
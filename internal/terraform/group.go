@@ -0,0 +1,50 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/group"
+)
+
+func ydbGroupResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        group.ResourceSchema(),
+		CreateContext: resourceYDBGroupCreate,
+		ReadContext:   resourceYDBGroupRead,
+		DeleteContext: resourceYDBGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultTimeouts(),
+	}
+}
+
+func resourceYDBGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return group.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return group.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return group.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
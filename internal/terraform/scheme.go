@@ -0,0 +1,44 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/scheme"
+)
+
+func ydbTablesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema:      scheme.ListDataSourceSchema(),
+		ReadContext: dataSourceYDBTablesRead,
+		Timeouts:    defaultTimeouts(),
+	}
+}
+
+func ydbSchemeEntryDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema:      scheme.EntryDataSourceSchema(),
+		ReadContext: dataSourceYDBSchemeEntryRead,
+		Timeouts:    defaultTimeouts(),
+	}
+}
+
+func dataSourceYDBTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return scheme.ListDataSourceReadFunc(cb, cfg.Endpoint)(ctx, d, meta)
+}
+
+func dataSourceYDBSchemeEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return scheme.EntryDataSourceReadFunc(cb, cfg.Endpoint)(ctx, d, meta)
+}
@@ -11,12 +11,14 @@ import (
 
 func ydbTableResource() *schema.Resource {
 	return &schema.Resource{
-		Schema:        table.ResourceSchema(),
-		SchemaVersion: 0,
-		CreateContext: resourceYDBTableCreate,
-		ReadContext:   resourceYDBTableRead,
-		UpdateContext: resourceYDBTableUpdate,
-		DeleteContext: resourceYDBTableDelete,
+		Schema:         table.ResourceSchema(),
+		SchemaVersion:  1,
+		StateUpgraders: table.ResourceStateUpgraders(),
+		CreateContext:  resourceYDBTableCreate,
+		ReadContext:    resourceYDBTableRead,
+		UpdateContext:  resourceYDBTableUpdate,
+		DeleteContext:  resourceYDBTableDelete,
+		CustomizeDiff:  resourceYDBTableCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -26,16 +28,22 @@ func ydbTableResource() *schema.Resource {
 
 func ydbTableDataSource() *schema.Resource {
 	return &schema.Resource{
-		Schema:        table.ResourceSchema(),
-		SchemaVersion: 0,
-		ReadContext:   dataSourceYDBTableRead,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Timeouts: defaultTimeouts(),
+		Schema:      table.DataSourceSchema(),
+		ReadContext: dataSourceYDBTableRead,
+		Timeouts:    defaultTimeouts(),
 	}
 }
 
+func resourceYDBTableCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	cfg, ok := meta.(*Config)
+	if !ok {
+		// NOTE: provider may be not configured yet, e.g. on validation.
+		return table.ResourceCustomizeDiff(ctx, d, meta)
+	}
+
+	return table.ResourceCustomizeDiffFunc(cfg.FailOnTableDataLoss)(ctx, d, meta)
+}
+
 func resourceYDBTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*Config)
 	cb := func(ctx context.Context) (string, error) {
@@ -78,5 +86,5 @@ func dataSourceYDBTableRead(ctx context.Context, d *schema.ResourceData, meta in
 		return cfg.Token, nil
 	}
 
-	return table.ResourceReadFunc(cb)(ctx, d, meta)
+	return table.DataSourceReadFunc(cb, cfg.Endpoint)(ctx, d, meta)
 }
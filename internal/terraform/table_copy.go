@@ -0,0 +1,48 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/table/tablecopy"
+)
+
+func ydbTableCopyResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        tablecopy.ResourceSchema(),
+		SchemaVersion: 0,
+		CreateContext: resourceYDBTableCopyCreate,
+		ReadContext:   resourceYDBTableCopyRead,
+		DeleteContext: resourceYDBTableCopyDelete,
+		Timeouts:      defaultTimeouts(),
+	}
+}
+
+func resourceYDBTableCopyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return tablecopy.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBTableCopyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return tablecopy.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBTableCopyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return tablecopy.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
@@ -17,6 +17,7 @@ func ydbTableIndexResource() *schema.Resource {
 		ReadContext:   resourceYDBTableIndexRead,
 		UpdateContext: resourceYDBTableIndexUpdate,
 		DeleteContext: resourceYDBTableIndexDelete,
+		CustomizeDiff: index.ResourceCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -9,8 +9,9 @@ import (
 )
 
 type Config struct {
-	Endpoint string
-	Token    string
+	Endpoint            string
+	Token               string
+	FailOnTableDataLoss bool
 }
 
 func Provider() *schema.Provider {
@@ -24,16 +25,32 @@ func Provider() *schema.Provider {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"fail_on_table_data_loss": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"ydb_topic": ydbTopicDataSource(),
-			"ydb_table": ydbTableDataSource(),
+			"ydb_topic":        ydbTopicDataSource(),
+			"ydb_table":        ydbTableDataSource(),
+			"ydb_tables":       ydbTablesDataSource(),
+			"ydb_scheme_entry": ydbSchemeEntryDataSource(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"ydb_topic":            ydbTopicResource(),
-			"ydb_table":            ydbTableResource(),
-			"ydb_table_changefeed": ydbTableChangeFeedResource(),
-			"ydb_table_index":      ydbTableIndexResource(),
+			"ydb_topic":                ydbTopicResource(),
+			"ydb_table":                ydbTableResource(),
+			"ydb_table_changefeed":     ydbTableChangeFeedResource(),
+			"ydb_table_index":          ydbTableIndexResource(),
+			"ydb_table_copy":           ydbTableCopyResource(),
+			"ydb_directory":            ydbDirectoryResource(),
+			"ydb_permissions":          ydbPermissionsResource(),
+			"ydb_user":                 ydbUserResource(),
+			"ydb_group":                ydbGroupResource(),
+			"ydb_view":                 ydbViewResource(),
+			"ydb_external_data_source": ydbExternalDataSourceResource(),
+			"ydb_external_table":       ydbExternalTableResource(),
+			"ydb_secret":               ydbSecretResource(),
 		},
 	}
 
@@ -43,8 +60,9 @@ func Provider() *schema.Provider {
 
 func configureProvider(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	cfg := &Config{
-		Endpoint: d.Get("endpoint").(string),
-		Token:    d.Get("token").(string),
+		Endpoint:            d.Get("endpoint").(string),
+		Token:               d.Get("token").(string),
+		FailOnTableDataLoss: d.Get("fail_on_table_data_loss").(bool),
 	}
 	return cfg, nil
 }
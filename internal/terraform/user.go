@@ -0,0 +1,60 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/user"
+)
+
+func ydbUserResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        user.ResourceSchema(),
+		CreateContext: resourceYDBUserCreate,
+		ReadContext:   resourceYDBUserRead,
+		UpdateContext: resourceYDBUserUpdate,
+		DeleteContext: resourceYDBUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: defaultTimeouts(),
+	}
+}
+
+func resourceYDBUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return user.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return user.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return user.ResourceUpdateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return user.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
@@ -0,0 +1,57 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/view"
+)
+
+func ydbViewResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        view.ResourceSchema(),
+		CreateContext: resourceYDBViewCreate,
+		ReadContext:   resourceYDBViewRead,
+		UpdateContext: resourceYDBViewUpdate,
+		DeleteContext: resourceYDBViewDelete,
+		Timeouts:      defaultTimeouts(),
+	}
+}
+
+func resourceYDBViewCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return view.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBViewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return view.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBViewUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return view.ResourceUpdateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBViewDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (string, error) {
+		return cfg.Token, nil
+	}
+
+	return view.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
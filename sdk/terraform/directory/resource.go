@@ -0,0 +1,106 @@
+package directory
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/directory"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+func ResourceCreateFunc(cb auth.GetTokenCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		token, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "failed to create token for YDB request",
+					Detail:   err.Error(),
+				},
+			}
+		}
+
+		h := directory.NewHandler(token)
+		return h.Create(ctx, d, meta)
+	}
+}
+
+func ResourceReadFunc(cb auth.GetTokenCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		token, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "failed to create token for YDB request",
+					Detail:   err.Error(),
+				},
+			}
+		}
+
+		h := directory.NewHandler(token)
+		return h.Read(ctx, d, meta)
+	}
+}
+
+func ResourceUpdateFunc(cb auth.GetTokenCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		token, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "failed to create token for YDB request",
+					Detail:   err.Error(),
+				},
+			}
+		}
+
+		h := directory.NewHandler(token)
+		return h.Update(ctx, d, meta)
+	}
+}
+
+func ResourceDeleteFunc(cb auth.GetTokenCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		token, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "failed to create token for YDB request",
+					Detail:   err.Error(),
+				},
+			}
+		}
+
+		h := directory.NewHandler(token)
+		return h.Delete(ctx, d, meta)
+	}
+}
+
+func ResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"connection_string": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"force_destroy": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
@@ -0,0 +1,116 @@
+package externaldatasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/externaldatasource"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+func ResourceCreateFunc(cb auth.GetTokenCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		token, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "failed to create token for YDB request",
+					Detail:   err.Error(),
+				},
+			}
+		}
+
+		h := externaldatasource.NewHandler(token)
+		return h.Create(ctx, d, meta)
+	}
+}
+
+func ResourceReadFunc(cb auth.GetTokenCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		token, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "failed to create token for YDB request",
+					Detail:   err.Error(),
+				},
+			}
+		}
+
+		h := externaldatasource.NewHandler(token)
+		return h.Read(ctx, d, meta)
+	}
+}
+
+func ResourceDeleteFunc(cb auth.GetTokenCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		token, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "failed to create token for YDB request",
+					Detail:   err.Error(),
+				},
+			}
+		}
+
+		h := externaldatasource.NewHandler(token)
+		return h.Delete(ctx, d, meta)
+	}
+}
+
+func ResourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return externaldatasource.CustomizeDiff(ctx, d, meta)
+}
+
+func ResourceSchema() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"connection_string": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"source_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "ObjectStorage",
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"location": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"auth_method": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      externaldatasource.AuthMethodNone,
+			ValidateFunc: validation.StringInSlice(externaldatasource.AuthMethods, false),
+		},
+	}
+	for _, v := range externaldatasource.AuthAttributes() {
+		s[v] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		}
+	}
+	return s
+}
@@ -0,0 +1,136 @@
+package externaltable
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers/yqltypes"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/externaltable"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+func ResourceCreateFunc(cb auth.GetTokenCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		token, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "failed to create token for YDB request",
+					Detail:   err.Error(),
+				},
+			}
+		}
+
+		h := externaltable.NewHandler(token)
+		return h.Create(ctx, d, meta)
+	}
+}
+
+func ResourceReadFunc(cb auth.GetTokenCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		token, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "failed to create token for YDB request",
+					Detail:   err.Error(),
+				},
+			}
+		}
+
+		h := externaltable.NewHandler(token)
+		return h.Read(ctx, d, meta)
+	}
+}
+
+func ResourceDeleteFunc(cb auth.GetTokenCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		token, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "failed to create token for YDB request",
+					Detail:   err.Error(),
+				},
+			}
+		}
+
+		h := externaltable.NewHandler(token)
+		return h.Delete(ctx, d, meta)
+	}
+}
+
+func ResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connection_string": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"data_source_path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"location": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"format": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(externaltable.Formats, false),
+		},
+		"compression": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(externaltable.Compressions, false),
+		},
+		"column": {
+			Type:     schema.TypeList,
+			Required: true,
+			ForceNew: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.NoZeroValues,
+					},
+					"type": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ForceNew:         true,
+						ValidateFunc:     yqltypes.ValidateColumnType,
+						DiffSuppressFunc: yqltypes.SuppressEquivalentColumnTypes,
+					},
+					"not_null": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,180 @@
+package scheme
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/scheme"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+// ListDataSourceReadFunc returns read function of scheme entries listing data source.
+// defaultConnectionString is used when connection_string is not set in the data source.
+func ListDataSourceReadFunc(cb auth.GetTokenCallback, defaultConnectionString string) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		token, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "failed to create token for YDB request",
+					Detail:   err.Error(),
+				},
+			}
+		}
+
+		return scheme.ReadListDataSource(ctx, d, scheme.DataSourceParams{
+			Token:                   token,
+			DefaultConnectionString: defaultConnectionString,
+		})
+	}
+}
+
+// EntryDataSourceReadFunc returns read function of scheme entry data source.
+// defaultConnectionString is used when connection_string is not set in the data source.
+func EntryDataSourceReadFunc(cb auth.GetTokenCallback, defaultConnectionString string) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		token, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "failed to create token for YDB request",
+					Detail:   err.Error(),
+				},
+			}
+		}
+
+		return scheme.ReadEntryDataSource(ctx, d, scheme.DataSourceParams{
+			Token:                   token,
+			DefaultConnectionString: defaultConnectionString,
+		})
+	}
+}
+
+func ListDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connection_string": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"path": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "",
+		},
+		"recursive": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"types": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(scheme.ListableEntryTypes, false),
+			},
+		},
+		"name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		"entries": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"path": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"paths": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func permissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"subject": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"permission_names": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func EntryDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connection_string": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"path": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"owner": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"permissions":           permissionsSchema(),
+		"effective_permissions": permissionsSchema(),
+		"created_at_plan_step": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"created_at_tx_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+}
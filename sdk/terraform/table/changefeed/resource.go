@@ -85,6 +85,11 @@ func ResourceDeleteFunc(cb auth.GetTokenCallback) helpers.TerraformCRUD {
 	}
 }
 
+// ResourceCustomizeDiff plans table attributes of the changefeed moved with its table.
+func ResourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return helpers.PlanTableReference(d)
+}
+
 func ResourceSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"table_path": {
@@ -92,7 +97,6 @@ func ResourceSchema() map[string]*schema.Schema {
 			Optional:     true,
 			Computed:     true,
 			ValidateFunc: validation.NoZeroValues,
-			ForceNew:     true,
 			ConflictsWith: []string{
 				"table_id",
 			},
@@ -110,7 +114,6 @@ func ResourceSchema() map[string]*schema.Schema {
 		"table_id": {
 			Type:     schema.TypeString,
 			Optional: true,
-			ForceNew: true,
 			Computed: true,
 			ConflictsWith: []string{
 				"table_path",
@@ -173,5 +176,10 @@ func ResourceSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"deletion_protection": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
 	}
 }
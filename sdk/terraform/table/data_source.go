@@ -1 +1,52 @@
 package table
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/table"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+// DataSourceReadFunc returns read function of table data source. defaultConnectionString is
+// used when connection_string is not set in the data source.
+func DataSourceReadFunc(cb auth.GetTokenCallback, defaultConnectionString string) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		token, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "failed to create token for YDB request",
+					Detail:   err.Error(),
+				},
+			}
+		}
+
+		return table.ReadDataSource(ctx, d, table.DataSourceParams{
+			Token:                   token,
+			DefaultConnectionString: defaultConnectionString,
+		})
+	}
+}
+
+// DataSourceSchema returns schema of table data source: the table is looked up by path,
+// all other attributes are computed.
+func DataSourceSchema() map[string]*schema.Schema {
+	s := TableDescriptionSchema()
+	s["path"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.NoZeroValues,
+	}
+	s["connection_string"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+	return s
+}
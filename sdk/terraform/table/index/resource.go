@@ -84,12 +84,16 @@ func ResourceDeleteFunc(cb auth.GetTokenCallback) helpers.TerraformCRUD {
 	}
 }
 
+// ResourceCustomizeDiff plans table attributes of the index moved with its table.
+func ResourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return helpers.PlanTableReference(d)
+}
+
 func ResourceSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"table_path": {
 			Type:         schema.TypeString,
 			Optional:     true,
-			ForceNew:     true,
 			Computed:     true,
 			ValidateFunc: validation.NoZeroValues,
 			ConflictsWith: []string{
@@ -109,7 +113,6 @@ func ResourceSchema() map[string]*schema.Schema {
 		"table_id": {
 			Type:     schema.TypeString,
 			Optional: true,
-			ForceNew: true,
 			Computed: true,
 			ConflictsWith: []string{
 				"table_path",
@@ -146,5 +149,10 @@ func ResourceSchema() map[string]*schema.Schema {
 				ValidateFunc: validation.NoZeroValues,
 			},
 		},
+		"deletion_protection": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
 	}
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers/topic"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers/yqltypes"
 	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/table"
 	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
 )
@@ -84,12 +86,77 @@ func ResourceReadFunc(cb auth.GetTokenCallback) helpers.TerraformCRUD {
 	}
 }
 
+func ResourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return table.CustomizeDiff(ctx, d, meta)
+}
+
+// ResourceCustomizeDiffFunc returns CustomizeDiff which also checks whether planned replacement
+// destroys a non-empty table. With failOnDataLoss such replacement is rejected.
+func ResourceCustomizeDiffFunc(failOnDataLoss bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if err := table.CustomizeDiff(ctx, d, meta); err != nil {
+			return err
+		}
+		return table.CheckReplacementDataLoss(d, failOnDataLoss)
+	}
+}
+
+// columnHash hashes column with normalized type, so type aliases do not change column identity.
+func columnHash(r *schema.Resource) schema.SchemaSetFunc {
+	hash := schema.HashResource(r)
+	return func(v interface{}) int {
+		mp := v.(map[string]interface{})
+		col := make(map[string]interface{}, len(mp))
+		for k, v := range mp {
+			col[k] = v
+		}
+		if typ, ok := col["type"].(string); ok {
+			if normalized, err := yqltypes.NormalizeColumnType(typ); err == nil {
+				col["type"] = normalized
+			}
+		}
+		return hash(col)
+	}
+}
+
 func ResourceSchema() map[string]*schema.Schema {
+	columnResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     yqltypes.ValidateColumnType,
+				DiffSuppressFunc: yqltypes.SuppressEquivalentColumnTypes,
+			},
+			"family": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"not_null": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+
 	return map[string]*schema.Schema{
-		"path": {
+		// NOTE: id is declared to be planned on rename, see CustomizeDiff.
+		"id": {
 			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Computed: true,
+		},
+		"path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
 		},
 		"connection_string": {
 			Type:     schema.TypeString,
@@ -99,6 +166,12 @@ func ResourceSchema() map[string]*schema.Schema {
 		"column": {
 			Type:     schema.TypeSet,
 			Required: true,
+			Set:      columnHash(columnResource),
+			Elem:     columnResource,
+		},
+		"index": {
+			Type:     schema.TypeSet,
+			Optional: true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"name": {
@@ -107,20 +180,85 @@ func ResourceSchema() map[string]*schema.Schema {
 						ValidateFunc: validation.NoZeroValues,
 					},
 					"type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "global_sync",
+						ValidateFunc: validation.StringInSlice([]string{"global_sync", "global_async"}, false),
+					},
+					"columns": {
+						Type:     schema.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+					"cover": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+		},
+		"changefeed": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
 						Type:         schema.TypeString,
 						Required:     true,
 						ValidateFunc: validation.NoZeroValues,
 					},
-					"family": {
+					"mode": {
 						Type:         schema.TypeString,
-						Optional:     true,
-						Computed:     true,
+						Required:     true,
 						ValidateFunc: validation.NoZeroValues,
 					},
-					"not_null": {
+					"format": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"virtual_timestamps": {
 						Type:     schema.TypeBool,
 						Optional: true,
-						Computed: true,
+					},
+					"retention_period": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.NoZeroValues,
+					},
+					"consumer": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.NoZeroValues,
+								},
+								"supported_codecs": {
+									Type:     schema.TypeList,
+									Optional: true,
+									Elem: &schema.Schema{
+										Type:         schema.TypeString,
+										ValidateFunc: validation.StringInSlice(topic.YDBTopicAllowedCodecs, false),
+									},
+									Computed: true,
+								},
+								"starting_message_timestamp_ms": {
+									Type:     schema.TypeInt,
+									Optional: true,
+									Computed: true,
+								},
+							},
+						},
 					},
 				},
 			},
@@ -167,9 +305,26 @@ func ResourceSchema() map[string]*schema.Schema {
 						ValidateFunc: validation.NoZeroValues,
 					},
 					"expire_interval": {
-						Type:         schema.TypeString,
-						Required:     true,
-						ValidateFunc: validation.NoZeroValues,
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"tier": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"expire_interval": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.NoZeroValues,
+								},
+								"external_data_source": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.NoZeroValues,
+								},
+							},
+						},
 					},
 				},
 			},
@@ -230,14 +385,306 @@ func ResourceSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"store": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "row",
+			ValidateFunc: validation.StringInSlice([]string{"row", "column"}, false),
+		},
+		"partition_by_hash": {
+			Type:     schema.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		"rows_estimate": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"store_size_bytes": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"current_partitions_count": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"partitions_count": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"creation_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"modification_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"partition_stats": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rows_estimate": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"store_size_bytes": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
 		"key_bloom_filter": {
 			Type:     schema.TypeBool,
 			Optional: true,
 			Computed: true,
 		},
-		"read_replicas_settings": {
-			Type:     schema.TypeString,
+		"deletion_protection": {
+			Type:     schema.TypeBool,
 			Optional: true,
+			Default:  false,
+		},
+		"migrate_data_on_replace": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"read_replicas": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mode": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"PER_AZ", "ANY_AZ"}, false),
+					},
+					"count": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceSchemaV0 is the schema of the table at version 0, before `read_replicas_settings`
+// string was replaced with `read_replicas` block. It is a frozen copy of the released schema
+// and must not follow later changes of ResourceSchema.
+func resourceSchemaV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"connection_string": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Required: true,
+			},
+			"column": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"family": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"not_null": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"family": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"data": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"compression": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"primary_key": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues, // TODO(shmel1k@): think about validate func
+				},
+			},
+			"ttl": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"expire_interval": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"partitioning_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uniform_partitions": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"partition_at_keys": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"keys": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"auto_partitioning_min_partitions_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"auto_partitioning_max_partitions_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"auto_partitioning_partition_size_mb": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"auto_partitioning_by_load": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"key_bloom_filter": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"read_replicas_settings": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func ResourceStateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    resourceSchemaV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: table.UpgradeStateV0,
 		},
 	}
 }
+
+func computedSchema(s *schema.Schema) *schema.Schema {
+	result := &schema.Schema{
+		Type:        s.Type,
+		Computed:    true,
+		Set:         s.Set,
+		Description: s.Description,
+	}
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		result.Elem = &schema.Resource{
+			Schema: computedSchemaMap(elem.Schema),
+		}
+	case *schema.Schema:
+		result.Elem = &schema.Schema{
+			Type: elem.Type,
+		}
+	}
+	return result
+}
+
+func computedSchemaMap(m map[string]*schema.Schema) map[string]*schema.Schema {
+	result := make(map[string]*schema.Schema, len(m))
+	for k, v := range m {
+		result[k] = computedSchema(v)
+	}
+	return result
+}
+
+// TableDescriptionSchema returns schema of table attributes set by table description
+// where all attributes are computed.
+func TableDescriptionSchema() map[string]*schema.Schema {
+	s := ResourceSchema()
+	delete(s, "id")
+	delete(s, "migrate_data_on_replace")
+	delete(s, "deletion_protection")
+	return computedSchemaMap(s)
+}
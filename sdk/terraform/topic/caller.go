@@ -57,6 +57,10 @@ func (c *caller) performYDBTopicUpdate(ctx context.Context, d *schema.ResourceDa
 
 	topicClient := ydbClient.Topic()
 
+	if !d.HasChangeExcept("deletion_protection") {
+		return c.resourceYDBTopicRead(ctx, d, nil)
+	}
+
 	if d.HasChange("name") {
 		// Creating new topic
 		return c.resourceYDBTopicCreate(ctx, d, nil)
@@ -165,6 +169,9 @@ func (c *caller) resourceYDBTopicDelete(ctx context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if diags := helpers.CheckDeletionProtection(d, "topic", topic.GetEntityPath()); diags != nil {
+		return diags
+	}
 
 	client, err := c.createYDBConnection(ctx, d, topic)
 	if err != nil {
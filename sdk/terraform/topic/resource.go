@@ -232,5 +232,10 @@ func ResourceSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"deletion_protection": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
 	}
 }